@@ -8,13 +8,17 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"text/tabwriter"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/yourselfhosted/slash/server"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/server/service/archive"
+	"github.com/yourselfhosted/slash/server/service/importer"
 	"github.com/yourselfhosted/slash/store"
 	"github.com/yourselfhosted/slash/store/db"
 )
@@ -32,6 +36,8 @@ var (
 	dsn           string
 	enableMetric  bool
 
+	migrationBackupRetention int
+
 	rootCmd = &cobra.Command{
 		Use:   "slash",
 		Short: `An open source, self-hosted links shortener and sharing platform.`,
@@ -87,6 +93,73 @@ var (
 			<-ctx.Done()
 		},
 	}
+
+	importFormat   string
+	importConflict string
+	importDryRun   bool
+	importCreator  string
+	importArchive  bool
+
+	importCmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: `Import shortcuts from a CSV, JSON or browser bookmark HTML file, or a workspace archive.`,
+		Args:  cobra.ExactArgs(1),
+		// Errors are not caused by the usage once the arguments are parsed.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if importArchive {
+				return importWorkspaceArchive(cmd.Context(), args[0])
+			}
+			return importShortcuts(cmd.Context(), args[0])
+		},
+	}
+
+	exportSecrets    bool
+	exportActivities bool
+
+	exportCmd = &cobra.Command{
+		Use:   "export [file]",
+		Short: `Export the workspace to a JSON archive, which can be imported with "import --archive".`,
+		Args:  cobra.MaximumNArgs(1),
+		// Errors are not caused by the usage once the arguments are parsed.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			return exportWorkspaceArchive(cmd.Context(), path)
+		},
+	}
+
+	migrateDryRun bool
+
+	migrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: `Apply the pending schema migrations to the database, or print them with --dry-run.`,
+		Args:  cobra.NoArgs,
+		// Errors are not caused by the usage once the arguments are parsed.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return migrateSchema(cmd.Context())
+		},
+	}
+
+	migrateFrom    string
+	migrateFromDSN string
+	migrateTo      string
+	migrateToDSN   string
+
+	migrateDBCmd = &cobra.Command{
+		Use:   "migrate-db",
+		Short: `Copy all the data from one database to another, e.g. from SQLite to Postgres, keeping the ids and timestamps.`,
+		Args:  cobra.NoArgs,
+		// Errors are not caused by the usage once the arguments are parsed.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return migrateDatabase(cmd.Context())
+		},
+	}
 )
 
 func Execute() error {
@@ -96,12 +169,38 @@ func Execute() error {
 func init() {
 	cobra.OnInitialize(initConfig)
 
+	importCmd.Flags().StringVarP(&importFormat, "format", "f", "", `format of the file, can be "csv", "json" or "html", detected from the content if empty`)
+	importCmd.Flags().StringVarP(&importConflict, "conflict", "", string(importer.ConflictSkip), `how to handle existing names, can be "skip", "overwrite" or "rename"`)
+	importCmd.Flags().BoolVarP(&importDryRun, "dry-run", "", false, "report the changes without making them")
+	importCmd.Flags().StringVarP(&importCreator, "creator", "", "", "email of the user who owns the imported shortcuts, defaults to the workspace owner")
+	importCmd.Flags().BoolVarP(&importArchive, "archive", "", false, "import a workspace archive created by export into a new workspace, keeping the ids")
+	rootCmd.AddCommand(importCmd)
+
+	exportCmd.Flags().BoolVarP(&exportSecrets, "include-secrets", "", false, "include the password hashes of users, the license key and the secrets of the identity providers")
+	exportCmd.Flags().BoolVarP(&exportActivities, "include-activities", "", false, "include the activities")
+	rootCmd.AddCommand(exportCmd)
+
+	migrateCmd.Flags().BoolVarP(&migrateDryRun, "dry-run", "", false, "print the pending migrations without applying them")
+	rootCmd.AddCommand(migrateCmd)
+
+	migrateDBCmd.Flags().StringVarP(&migrateFrom, "from", "", "sqlite", `driver of the source database, can be "sqlite", "postgres" or "mysql"`)
+	migrateDBCmd.Flags().StringVarP(&migrateFromDSN, "from-dsn", "", "", "DSN of the source database")
+	migrateDBCmd.Flags().StringVarP(&migrateTo, "to", "", "postgres", `driver of the target database, can be "sqlite", "postgres" or "mysql"`)
+	migrateDBCmd.Flags().StringVarP(&migrateToDSN, "to-dsn", "", "", "DSN of the target database, whose tables must be empty")
+	for _, name := range []string{"from-dsn", "to-dsn"} {
+		if err := migrateDBCmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+	rootCmd.AddCommand(migrateDBCmd)
+
 	rootCmd.PersistentFlags().StringVarP(&mode, "mode", "m", "demo", `mode of server, can be "prod" or "dev" or "demo"`)
 	rootCmd.PersistentFlags().IntVarP(&port, "port", "p", 8082, "port of server")
 	rootCmd.PersistentFlags().StringVarP(&data, "data", "d", "", "data directory")
 	rootCmd.PersistentFlags().StringVarP(&driver, "driver", "", "", "database driver")
 	rootCmd.PersistentFlags().StringVarP(&dsn, "dsn", "", "", "database source name(aka. DSN)")
 	rootCmd.PersistentFlags().BoolVarP(&enableMetric, "metric", "", true, "allow metric collection")
+	rootCmd.PersistentFlags().IntVarP(&migrationBackupRetention, "migration-backup-retention", "", 3, "number of the most recent sqlite backups before migration to keep, 0 to keep all")
 
 	err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode"))
 	if err != nil {
@@ -127,11 +226,16 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = viper.BindPFlag("migration_backup_retention", rootCmd.PersistentFlags().Lookup("migration-backup-retention"))
+	if err != nil {
+		panic(err)
+	}
 
 	viper.SetDefault("mode", "demo")
 	viper.SetDefault("port", 8082)
 	viper.SetDefault("driver", "sqlite")
 	viper.SetDefault("metric", true)
+	viper.SetDefault("migration_backup_retention", 3)
 	viper.SetEnvPrefix("slash")
 }
 
@@ -153,6 +257,219 @@ func initConfig() {
 	println("---")
 }
 
+// openStore opens the database of the server profile and migrates it to the current version.
+func openStore(ctx context.Context) (*store.Store, error) {
+	dbDriver, err := db.NewDBDriver(serverProfile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db driver")
+	}
+	if err := dbDriver.Migrate(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate db")
+	}
+	return store.New(dbDriver, serverProfile), nil
+}
+
+func importShortcuts(ctx context.Context, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read file")
+	}
+	format := importer.Format(importFormat)
+	if format == "" {
+		format = importer.DetectFormat(content)
+	}
+	conflict := importer.ConflictPolicy(importConflict)
+	if conflict != importer.ConflictSkip && conflict != importer.ConflictOverwrite && conflict != importer.ConflictRename {
+		return errors.Errorf("invalid conflict policy %q", importConflict)
+	}
+	shortcuts, err := importer.Parse(format, content)
+	if err != nil {
+		return err
+	}
+
+	storeInstance, err := openStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer storeInstance.Close()
+	find := &store.FindUser{}
+	if importCreator != "" {
+		find.Email = &importCreator
+	} else {
+		adminRole := store.RoleAdmin
+		find.Role = &adminRole
+	}
+	creator, err := storeInstance.GetUser(ctx, find)
+	if err != nil {
+		return errors.Wrap(err, "failed to get creator")
+	}
+	if creator == nil {
+		return errors.New("creator not found, sign up first or set --creator")
+	}
+
+	results, err := importer.NewImportService(storeInstance).Import(ctx, shortcuts, &importer.Options{
+		CreatorID: creator.ID,
+		Conflict:  conflict,
+		DryRun:    importDryRun,
+	})
+	if err != nil {
+		return err
+	}
+	counts := map[importer.Action]int{}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, result := range results {
+		counts[result.Action]++
+		name := result.Name
+		if result.ImportedName != result.Name {
+			name = fmt.Sprintf("%s -> %s", result.Name, result.ImportedName)
+		}
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", result.Action, name, result.Message); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("%d created, %d updated, %d skipped, %d failed.\n", counts[importer.ActionCreated], counts[importer.ActionUpdated], counts[importer.ActionSkipped], counts[importer.ActionFailed])
+	if importDryRun {
+		println("Dry run, no changes were made.")
+	}
+	return nil
+}
+
+func importWorkspaceArchive(ctx context.Context, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read file")
+	}
+	workspaceArchive, err := archive.Unmarshal(content)
+	if err != nil {
+		return err
+	}
+
+	storeInstance, err := openStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer storeInstance.Close()
+	if err := archive.NewArchiveService(serverProfile, storeInstance).Import(ctx, workspaceArchive); err != nil {
+		return err
+	}
+	fmt.Printf("Imported %d users, %d shortcuts, %d collections and %d activities.\n", len(workspaceArchive.Users), len(workspaceArchive.Shortcuts), len(workspaceArchive.Collections), len(workspaceArchive.Activities))
+	return nil
+}
+
+func exportWorkspaceArchive(ctx context.Context, path string) error {
+	storeInstance, err := openStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer storeInstance.Close()
+	workspaceArchive, err := archive.NewArchiveService(serverProfile, storeInstance).Export(ctx, &archive.ExportOptions{
+		IncludeSecrets:    exportSecrets,
+		IncludeActivities: exportActivities,
+	})
+	if err != nil {
+		return err
+	}
+	content, err := archive.Marshal(workspaceArchive)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal archive")
+	}
+	if path == "" {
+		path = archive.GetFileName(workspaceArchive)
+	}
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrap(err, "failed to write archive")
+	}
+	fmt.Printf("Exported %d users, %d shortcuts and %d collections to %s.\n", len(workspaceArchive.Users), len(workspaceArchive.Shortcuts), len(workspaceArchive.Collections), path)
+	return nil
+}
+
+func migrateSchema(ctx context.Context) error {
+	dbDriver, err := db.NewDBDriver(serverProfile)
+	if err != nil {
+		return errors.Wrap(err, "failed to create db driver")
+	}
+	defer dbDriver.Close()
+	migrations, err := dbDriver.ListPendingMigrations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending migrations")
+	}
+	if len(migrations) == 0 {
+		println("The database is up to date.")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, migration := range migrations {
+		for _, file := range migration.Files {
+			if _, err := fmt.Fprintf(writer, "%s\t%s\n", migration.Version, file); err != nil {
+				return err
+			}
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if migrateDryRun {
+		fmt.Printf("%d pending migrations, dry run, no changes were made.\n", len(migrations))
+		return nil
+	}
+	if err := dbDriver.Migrate(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate db")
+	}
+	fmt.Printf("Applied %d migrations.\n", len(migrations))
+	return nil
+}
+
+// openDBDriver opens the database and migrates it to the current version.
+func openDBDriver(ctx context.Context, driver, dsn string) (store.Driver, error) {
+	dbDriver, err := db.NewDBDriver(&profile.Profile{
+		Mode:    "prod",
+		Driver:  driver,
+		DSN:     dsn,
+		Version: serverProfile.Version,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s db driver", driver)
+	}
+	if err := dbDriver.Migrate(ctx); err != nil {
+		dbDriver.Close()
+		return nil, errors.Wrapf(err, "failed to migrate %s db", driver)
+	}
+	return dbDriver, nil
+}
+
+func migrateDatabase(ctx context.Context) error {
+	source, err := openDBDriver(ctx, migrateFrom, migrateFromDSN)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	target, err := openDBDriver(ctx, migrateTo, migrateToDSN)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	results, err := db.CopyData(ctx, migrateFrom, source, migrateTo, target)
+	if err != nil {
+		return err
+	}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, result := range results {
+		if _, err := fmt.Fprintf(writer, "%s\t%d rows\n", result.Table, result.TargetRows); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("Copied %d tables from %s to %s.\n", len(results), migrateFrom, migrateTo)
+	return nil
+}
+
 func printGreetings() {
 	println(greetingBanner)
 	fmt.Printf("Version %s has been started on port %d\n", serverProfile.Version, serverProfile.Port)
@@ -163,8 +480,8 @@ func printGreetings() {
 }
 
 func main() {
-	err := Execute()
-	if err != nil {
-		panic(err)
+	if err := Execute(); err != nil {
+		// The error has been printed by cobra.
+		os.Exit(1)
 	}
 }
@@ -1,5 +1,7 @@
 import { createChannel, createClientFactory, FetchTransport } from "nice-grpc-web";
+import { AnalyticsServiceDefinition } from "./types/proto/api/v1/analytics_service";
 import { AuthServiceDefinition } from "./types/proto/api/v1/auth_service";
+import { BackupServiceDefinition } from "./types/proto/api/v1/backup_service";
 import { CollectionServiceDefinition } from "./types/proto/api/v1/collection_service";
 import { ShortcutServiceDefinition } from "./types/proto/api/v1/shortcut_service";
 import { SubscriptionServiceDefinition } from "./types/proto/api/v1/subscription_service";
@@ -31,3 +33,7 @@ export const userSettingServiceClient = clientFactory.create(UserSettingServiceD
 export const shortcutServiceClient = clientFactory.create(ShortcutServiceDefinition, channel);
 
 export const collectionServiceClient = clientFactory.create(CollectionServiceDefinition, channel);
+
+export const analyticsServiceClient = clientFactory.create(AnalyticsServiceDefinition, channel);
+
+export const backupServiceClient = clientFactory.create(BackupServiceDefinition, channel);
@@ -6,7 +6,7 @@ import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
 import { isURL } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import { useShortcutStore, useUserStore } from "@/stores";
-import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
+import { Shortcut, ShortcutSuggestion } from "@/types/proto/api/v1/shortcut_service";
 
 const ShortcutSpace = () => {
   const params = useParams();
@@ -16,6 +16,7 @@ const ShortcutSpace = () => {
   const currentUser = userStore.getCurrentUser();
   const shortcutStore = useShortcutStore();
   const [shortcut, setShortcut] = useState<Shortcut>();
+  const [suggestions, setSuggestions] = useState<ShortcutSuggestion[]>([]);
   const [loading, setLoading] = useState(true);
   const [showCreateShortcutDrawer, setShowCreateShortcutDrawer] = useState(false);
 
@@ -27,6 +28,11 @@ const ShortcutSpace = () => {
       } catch (error: any) {
         console.error(error);
         toast.error(error.details);
+        try {
+          setSuggestions(await shortcutStore.fetchShortcutSuggestions(shortcutName));
+        } catch (error: any) {
+          console.error(error);
+        }
       }
       setLoading(false);
     })();
@@ -37,23 +43,35 @@ const ShortcutSpace = () => {
   }
 
   if (!shortcut) {
-    if (!currentUser) {
+    if (!currentUser && suggestions.length === 0) {
       navigateTo("/404");
       return null;
     }
 
-    // If shortcut is not found, prompt user to create it.
+    // If shortcut is not found, suggest the similar ones and prompt user to create it.
     return (
       <>
         <div className="w-full h-[100svh] flex flex-col justify-center items-center p-4">
           <p className="text-xl">
             Shortcut <span className="font-mono">{shortcutName}</span> Not Found.
           </p>
-          <div className="mt-4">
-            <Button variant="plain" size="sm" onClick={() => setShowCreateShortcutDrawer(true)}>
-              👉 Click here to create it
-            </Button>
-          </div>
+          {suggestions.length > 0 && (
+            <div className="mt-4 flex flex-col justify-center items-center">
+              <p className="text-gray-500">Did you mean:</p>
+              {suggestions.map((suggestion) => (
+                <a key={suggestion.name} className="mt-1 font-mono hover:underline" href={`/s/${suggestion.name}`}>
+                  {suggestion.name}
+                </a>
+              ))}
+            </div>
+          )}
+          {currentUser && (
+            <div className="mt-4">
+              <Button variant="plain" size="sm" onClick={() => setShowCreateShortcutDrawer(true)}>
+                👉 Click here to create it
+              </Button>
+            </div>
+          )}
         </div>
         {showCreateShortcutDrawer && (
           <CreateShortcutDrawer
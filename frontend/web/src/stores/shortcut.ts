@@ -25,6 +25,18 @@ const useShortcutStore = create(
       set({ shortcutMapById: shortcutMap });
       return shortcuts;
     },
+    searchShortcuts: async (query: string) => {
+      const { results } = await shortcutServiceClient.searchShortcuts({
+        query,
+      });
+      return results;
+    },
+    fetchShortcutSuggestions: async (name: string) => {
+      const { suggestions } = await shortcutServiceClient.listShortcutSuggestions({
+        name,
+      });
+      return suggestions;
+    },
     fetchShortcutByName: async (name: string) => {
       const { shortcut } = await shortcutServiceClient.getShortcutByName({
         name,
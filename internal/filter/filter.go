@@ -0,0 +1,180 @@
+// Package filter parses the filter expressions of list requests.
+//
+// A filter is a list of conditions joined with "&&", for example:
+//
+//	tag == "work" && created_time >= "2024-01-01T00:00:00Z" && name.startsWith("gh")
+//
+// A condition either compares a field with a value, or calls a function on a field.
+// Values are double-quoted strings, numbers or bare identifiers.
+package filter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Condition is a single condition of a filter.
+type Condition struct {
+	// Field is the name of the field, e.g. "tag".
+	Field string
+	// Operator is either a comparison operator, e.g. "==" or ">=",
+	// or the name of the called function, e.g. "startsWith".
+	Operator string
+	// Value is the unquoted value.
+	Value string
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+var symbols = []string{"&&", "==", "!=", ">=", "<=", ">", "<", "(", ")"}
+
+var comparisonOperators = map[string]bool{
+	"==": true,
+	"!=": true,
+	">=": true,
+	"<=": true,
+	">":  true,
+	"<":  true,
+}
+
+func isIdentChar(c byte, first bool) bool {
+	if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return true
+	}
+	return !first && (c == '.' || (c >= '0' && c <= '9'))
+}
+
+func tokenize(filter string) ([]*token, error) {
+	tokens := []*token{}
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(filter) && filter[end] != '"' {
+				if filter[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(filter) {
+				return nil, errors.Errorf("unterminated string at %d", i)
+			}
+			value, err := strconv.Unquote(filter[i : end+1])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid string at %d", i)
+			}
+			tokens = append(tokens, &token{kind: tokenString, value: value})
+			i = end + 1
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(filter) && filter[end] >= '0' && filter[end] <= '9' {
+				end++
+			}
+			tokens = append(tokens, &token{kind: tokenNumber, value: filter[i:end]})
+			i = end
+		case isIdentChar(c, true):
+			end := i + 1
+			for end < len(filter) && isIdentChar(filter[end], false) {
+				end++
+			}
+			tokens = append(tokens, &token{kind: tokenIdent, value: filter[i:end]})
+			i = end
+		default:
+			matched := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(filter[i:], symbol) {
+					tokens = append(tokens, &token{kind: tokenSymbol, value: symbol})
+					i += len(symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, errors.Errorf("unexpected character %q at %d", c, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// Parse parses the filter into its conditions. An empty filter has no conditions.
+func Parse(filter string) ([]*Condition, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := []*Condition{}
+	next := func() *token {
+		if len(tokens) == 0 {
+			return nil
+		}
+		t := tokens[0]
+		tokens = tokens[1:]
+		return t
+	}
+	nextValue := func() (string, error) {
+		t := next()
+		if t == nil || t.kind == tokenSymbol {
+			return "", errors.New("expected a value")
+		}
+		return t.value, nil
+	}
+
+	for len(tokens) > 0 {
+		field := next()
+		if field.kind != tokenIdent {
+			return nil, errors.Errorf("expected a field, got %q", field.value)
+		}
+		operator := next()
+		if operator == nil || operator.kind != tokenSymbol {
+			return nil, errors.Errorf("expected an operator after %q", field.value)
+		}
+
+		condition := &Condition{}
+		switch {
+		case operator.value == "(":
+			index := strings.LastIndex(field.value, ".")
+			if index <= 0 {
+				return nil, errors.Errorf("expected a function call on a field, got %q", field.value)
+			}
+			condition.Field, condition.Operator = field.value[:index], field.value[index+1:]
+			if condition.Value, err = nextValue(); err != nil {
+				return nil, err
+			}
+			if t := next(); t == nil || t.value != ")" {
+				return nil, errors.Errorf("expected \")\" after the argument of %q", field.value)
+			}
+		case comparisonOperators[operator.value]:
+			condition.Field, condition.Operator = field.value, operator.value
+			if condition.Value, err = nextValue(); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("unexpected operator %q", operator.value)
+		}
+		conditions = append(conditions, condition)
+
+		if t := next(); t != nil && t.value != "&&" {
+			return nil, errors.Errorf("expected \"&&\", got %q", t.value)
+		}
+	}
+	return conditions, nil
+}
@@ -0,0 +1,74 @@
+package filter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yourselfhosted/slash/internal/filter"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		filter  string
+		want    []*filter.Condition
+		wantErr bool
+	}{
+		{
+			filter: "",
+			want:   []*filter.Condition{},
+		},
+		{
+			filter: `tag == "work"`,
+			want: []*filter.Condition{
+				{Field: "tag", Operator: "==", Value: "work"},
+			},
+		},
+		{
+			filter: `visibility == PUBLIC && created_time >= "2024-01-01T00:00:00Z" && name.startsWith("g\"h")`,
+			want: []*filter.Condition{
+				{Field: "visibility", Operator: "==", Value: "PUBLIC"},
+				{Field: "created_time", Operator: ">=", Value: "2024-01-01T00:00:00Z"},
+				{Field: "name", Operator: "startsWith", Value: `g"h`},
+			},
+		},
+		{
+			filter: `creator_id != 12`,
+			want: []*filter.Condition{
+				{Field: "creator_id", Operator: "!=", Value: "12"},
+			},
+		},
+		{
+			filter:  `tag == "work`,
+			wantErr: true,
+		},
+		{
+			filter:  `tag ==`,
+			wantErr: true,
+		},
+		{
+			filter:  `tag == "a" || tag == "b"`,
+			wantErr: true,
+		},
+		{
+			filter:  `tag == "a" tag == "b"`,
+			wantErr: true,
+		},
+		{
+			filter:  `startsWith("a")`,
+			wantErr: true,
+		},
+		{
+			filter:  `name.startsWith("a"`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		conditions, err := filter.Parse(test.filter)
+		if (err != nil) != test.wantErr {
+			t.Fatalf("Parse(%q) got error %v, want error %v.", test.filter, err, test.wantErr)
+		}
+		if !test.wantErr && !reflect.DeepEqual(conditions, test.want) {
+			t.Errorf("Parse(%q) got conditions %+v, want %+v.", test.filter, conditions, test.want)
+		}
+	}
+}
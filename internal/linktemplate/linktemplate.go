@@ -0,0 +1,121 @@
+// Package linktemplate implements the placeholder syntax of parameterized shortcut links.
+//
+// A link template may contain placeholders wrapped in curly braces:
+//
+//   - {1}, {2}, ... are replaced with the path segments following the shortcut name.
+//   - {name} is replaced with the value of the query parameter "name".
+//
+// Any other curly braces are literal text of the link.
+//
+// For example, with the template "https://jira.example.com/browse/{1}",
+// the path "s/jira/ABC-123" resolves to "https://jira.example.com/browse/ABC-123".
+package linktemplate
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// placeholderNameRegexp matches the valid names of placeholders.
+var placeholderNameRegexp = regexp.MustCompile(`^([1-9][0-9]*|[A-Za-z_][A-Za-z0-9_]*)$`)
+
+type token struct {
+	// literal is the raw text of a literal token.
+	literal string
+	// placeholder is the name of a placeholder token.
+	placeholder string
+	// inQuery is true if the token is part of the query or fragment of the link.
+	inQuery bool
+}
+
+// parse splits the link into literal and placeholder tokens.
+// Braces which do not wrap a valid placeholder name are kept as literal text, e.g. in "https://example.com/{a-b}".
+func parse(link string) []token {
+	tokens := []token{}
+	inQuery := false
+	literal := ""
+	appendLiteral := func() {
+		if literal != "" {
+			tokens = append(tokens, token{literal: literal, inQuery: inQuery})
+			inQuery = inQuery || strings.ContainsAny(literal, "?#")
+			literal = ""
+		}
+	}
+	for len(link) > 0 {
+		start := strings.IndexByte(link, '{')
+		if start < 0 {
+			literal += link
+			break
+		}
+		end := strings.IndexByte(link[start:], '}')
+		if end < 0 || !placeholderNameRegexp.MatchString(link[start+1:start+end]) {
+			literal += link[:start+1]
+			link = link[start+1:]
+			continue
+		}
+		literal += link[:start]
+		appendLiteral()
+		tokens = append(tokens, token{placeholder: link[start+1 : start+end], inQuery: inQuery})
+		link = link[start+end+1:]
+	}
+	appendLiteral()
+	return tokens
+}
+
+// Validate checks that the link template is a valid link once its placeholders are filled in.
+func Validate(link string) error {
+	tokens := parse(link)
+	parts := []string{}
+	for _, token := range tokens {
+		if token.placeholder != "" {
+			parts = append(parts, "placeholder")
+		} else {
+			parts = append(parts, token.literal)
+		}
+	}
+	if _, err := url.Parse(strings.Join(parts, "")); err != nil {
+		return errors.Wrap(err, "invalid link")
+	}
+	return nil
+}
+
+// HasPlaceholders returns true if the link contains any placeholder.
+func HasPlaceholders(link string) bool {
+	for _, token := range parse(link) {
+		if token.placeholder != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Render replaces the placeholders of the link template with the given path segments and query values.
+// Missing values are replaced with an empty string.
+func Render(link string, segments []string, query url.Values) string {
+	parts := []string{}
+	for _, token := range parse(link) {
+		if token.placeholder == "" {
+			parts = append(parts, token.literal)
+			continue
+		}
+
+		value := ""
+		if index, err := strconv.Atoi(token.placeholder); err == nil {
+			if index <= len(segments) {
+				value = segments[index-1]
+			}
+		} else {
+			value = query.Get(token.placeholder)
+		}
+		if token.inQuery {
+			parts = append(parts, url.QueryEscape(value))
+		} else {
+			parts = append(parts, url.PathEscape(value))
+		}
+	}
+	return strings.Join(parts, "")
+}
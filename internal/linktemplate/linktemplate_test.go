@@ -0,0 +1,109 @@
+package linktemplate_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/yourselfhosted/slash/internal/linktemplate"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		link    string
+		wantErr bool
+	}{
+		{
+			link:    "https://example.com",
+			wantErr: false,
+		},
+		{
+			link:    "https://jira.example.com/browse/{1}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/search?q={q}&page={page_number}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/{1",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/1}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/{}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/{0}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/{a-b}",
+			wantErr: false,
+		},
+		{
+			link:    "https://example.com/{1}%zz",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		err := linktemplate.Validate(test.link)
+		if (err != nil) != test.wantErr {
+			t.Errorf("Validate(%q) got error %v, want error %v.", test.link, err, test.wantErr)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		link     string
+		segments []string
+		query    url.Values
+		want     string
+	}{
+		{
+			link: "https://example.com",
+			want: "https://example.com",
+		},
+		{
+			link:     "https://jira.example.com/browse/{1}",
+			segments: []string{"ABC-123"},
+			want:     "https://jira.example.com/browse/ABC-123",
+		},
+		{
+			link:     "https://github.com/{1}/{2}/pulls",
+			segments: []string{"yourselfhosted"},
+			want:     "https://github.com/yourselfhosted//pulls",
+		},
+		{
+			link:  "https://x/?q={q}",
+			query: url.Values{"q": []string{"foo bar&baz"}},
+			want:  "https://x/?q=foo+bar%26baz",
+		},
+		{
+			link:     "https://example.com/{1}?q={1}",
+			segments: []string{"a b"},
+			want:     "https://example.com/a%20b?q=a+b",
+		},
+		{
+			link:     "https://example.com/{{1}}?json={\"a\":{a}}",
+			segments: []string{"x"},
+			query:    url.Values{"a": []string{"1"}},
+			want:     "https://example.com/{x}?json={\"a\":1}",
+		},
+		{
+			link:     "https://example.com/{0}/{1",
+			segments: []string{"x"},
+			want:     "https://example.com/{0}/{1",
+		},
+	}
+	for _, test := range tests {
+		result := linktemplate.Render(test.link, test.segments, test.query)
+		if result != test.want {
+			t.Errorf("Render(%q) got result %q, want %q.", test.link, result, test.want)
+		}
+	}
+}
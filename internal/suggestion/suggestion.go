@@ -0,0 +1,94 @@
+// Package suggestion ranks the names similar to a name which does not exist,
+// to offer "did you mean" suggestions for mistyped shortcut names.
+package suggestion
+
+import (
+	"slices"
+	"strings"
+)
+
+// prefixBonus is added to the score of names starting with the query.
+const prefixBonus = 0.5
+
+// Match is a name similar to the query.
+type Match struct {
+	Name string
+	// Score is the similarity to the query, where higher is more similar.
+	Score float64
+}
+
+// Distance returns the Levenshtein edit distance between a and b.
+func Distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous, current := make([]int, len(t)+1), make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(t)]
+}
+
+// MaxDistance returns the maximum edit distance of suggestions, which grows with the length of the query.
+func MaxDistance(query string) int {
+	length := len([]rune(query))
+	switch {
+	case length <= 3:
+		return 1
+	case length <= 6:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Rank returns the names similar to the query, sorted by score in descending order.
+// Names are compared case-insensitively, and a name is similar if it is within a small
+// edit distance from the query or if it starts with the query.
+func Rank(query string, names []string) []*Match {
+	matches := []*Match{}
+	if query == "" {
+		return matches
+	}
+	originalQuery := query
+	query = strings.ToLower(query)
+	for _, name := range names {
+		if name == "" || name == originalQuery {
+			continue
+		}
+		lowerName := strings.ToLower(name)
+		distance := Distance(query, lowerName)
+		isPrefix := strings.HasPrefix(lowerName, query)
+		if distance > MaxDistance(query) && !isPrefix {
+			continue
+		}
+
+		score := 1 - float64(distance)/float64(max(len([]rune(query)), len([]rune(lowerName))))
+		if isPrefix {
+			score += prefixBonus
+		}
+		matches = append(matches, &Match{
+			Name:  name,
+			Score: score,
+		})
+	}
+	slices.SortStableFunc(matches, func(a, b *Match) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return matches
+}
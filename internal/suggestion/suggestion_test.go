@@ -0,0 +1,85 @@
+package suggestion_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yourselfhosted/slash/internal/suggestion"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{
+			a:    "",
+			b:    "",
+			want: 0,
+		},
+		{
+			a:    "gh",
+			b:    "",
+			want: 2,
+		},
+		{
+			a:    "kitten",
+			b:    "sitting",
+			want: 3,
+		},
+		{
+			a:    "jira",
+			b:    "jria",
+			want: 2,
+		},
+		{
+			a:    "café",
+			b:    "cafe",
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		if got := suggestion.Distance(test.a, test.b); got != test.want {
+			t.Errorf("Distance(%q, %q) got %d, want %d.", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		query string
+		names []string
+		want  []string
+	}{
+		{
+			query: "",
+			names: []string{"gh"},
+			want:  []string{},
+		},
+		{
+			query: "GH",
+			names: []string{"gh", "go", "docs"},
+			want:  []string{"gh", "go"},
+		},
+		{
+			query: "calender",
+			names: []string{"calendar", "cal", "mail", "calendar-team"},
+			want:  []string{"calendar"},
+		},
+		{
+			query: "doc",
+			names: []string{"docs", "design-docs", "dog", "documents"},
+			want:  []string{"docs", "documents", "dog"},
+		},
+	}
+	for _, test := range tests {
+		names := []string{}
+		for _, match := range suggestion.Rank(test.query, test.names) {
+			names = append(names, match.Name)
+		}
+		if !reflect.DeepEqual(names, test.want) {
+			t.Errorf("Rank(%q) got %v, want %v.", test.query, names, test.want)
+		}
+	}
+}
@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: api/v1/analytics_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetWorkspaceAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The start of the time range, inclusive. Defaults to 30 days before the end time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the time range, exclusive. Defaults to now.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The maximum number of items in each top list. Defaults to 10.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsRequest) Reset() {
+	*x = GetWorkspaceAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetWorkspaceAnalyticsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The shortcuts with the most views.
+	TopShortcuts []*GetWorkspaceAnalyticsResponse_ShortcutItem `protobuf:"bytes,1,rep,name=top_shortcuts,json=topShortcuts,proto3" json:"top_shortcuts,omitempty"`
+	// The referers with the most views.
+	TopReferers []*GetWorkspaceAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=top_referers,json=topReferers,proto3" json:"top_referers,omitempty"`
+	// The views per day.
+	Views []*GetWorkspaceAnalyticsResponse_TimeSeriesItem `protobuf:"bytes,3,rep,name=views,proto3" json:"views,omitempty"`
+	// The shortcuts created per day.
+	NewShortcuts []*GetWorkspaceAnalyticsResponse_TimeSeriesItem `protobuf:"bytes,4,rep,name=new_shortcuts,json=newShortcuts,proto3" json:"new_shortcuts,omitempty"`
+	// The users who created the most shortcuts.
+	TopCreators []*GetWorkspaceAnalyticsResponse_CreatorItem `protobuf:"bytes,5,rep,name=top_creators,json=topCreators,proto3" json:"top_creators,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsResponse) Reset() {
+	*x = GetWorkspaceAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWorkspaceAnalyticsResponse) GetTopShortcuts() []*GetWorkspaceAnalyticsResponse_ShortcutItem {
+	if x != nil {
+		return x.TopShortcuts
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsResponse) GetTopReferers() []*GetWorkspaceAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.TopReferers
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsResponse) GetViews() []*GetWorkspaceAnalyticsResponse_TimeSeriesItem {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsResponse) GetNewShortcuts() []*GetWorkspaceAnalyticsResponse_TimeSeriesItem {
+	if x != nil {
+		return x.NewShortcuts
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsResponse) GetTopCreators() []*GetWorkspaceAnalyticsResponse_CreatorItem {
+	if x != nil {
+		return x.TopCreators
+	}
+	return nil
+}
+
+type GetWorkspaceAnalyticsResponse_ShortcutItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortcutId int32  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_ShortcutItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_ShortcutItem) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_ShortcutItem.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_ShortcutItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse_AnalyticsItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsResponse_AnalyticsItem) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_AnalyticsItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_AnalyticsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_AnalyticsItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetWorkspaceAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse_TimeSeriesItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The start time of the day.
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Count int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsResponse_TimeSeriesItem) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_TimeSeriesItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_TimeSeriesItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_TimeSeriesItem) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_TimeSeriesItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_TimeSeriesItem.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_TimeSeriesItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_TimeSeriesItem) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GetWorkspaceAnalyticsResponse_TimeSeriesItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse_CreatorItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the creator.
+	// Format: "users/{id}"
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// The number of shortcuts created in the time range.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_CreatorItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_analytics_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_CreatorItem) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_CreatorItem.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_CreatorItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_service_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_api_v1_analytics_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_analytics_service_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb4, 0x06, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x63, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x5f, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x1a, 0x59, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x63, 0x75, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x39, 0x0a, 0x0d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x56, 0x0a, 0x0e, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x59, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xa0, 0x01,
+	0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2a, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x42, 0xb3, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x15, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x6c, 0x66, 0x68, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0c, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x3a, 0x3a, 0x41,
+	0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_analytics_service_proto_rawDescOnce sync.Once
+	file_api_v1_analytics_service_proto_rawDescData = file_api_v1_analytics_service_proto_rawDesc
+)
+
+func file_api_v1_analytics_service_proto_rawDescGZIP() []byte {
+	file_api_v1_analytics_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_analytics_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_analytics_service_proto_rawDescData)
+	})
+	return file_api_v1_analytics_service_proto_rawDescData
+}
+
+var file_api_v1_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_analytics_service_proto_goTypes = []interface{}{
+	(*GetWorkspaceAnalyticsRequest)(nil),                 // 0: slash.api.v1.GetWorkspaceAnalyticsRequest
+	(*GetWorkspaceAnalyticsResponse)(nil),                // 1: slash.api.v1.GetWorkspaceAnalyticsResponse
+	(*GetWorkspaceAnalyticsResponse_ShortcutItem)(nil),   // 2: slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutItem
+	(*GetWorkspaceAnalyticsResponse_AnalyticsItem)(nil),  // 3: slash.api.v1.GetWorkspaceAnalyticsResponse.AnalyticsItem
+	(*GetWorkspaceAnalyticsResponse_TimeSeriesItem)(nil), // 4: slash.api.v1.GetWorkspaceAnalyticsResponse.TimeSeriesItem
+	(*GetWorkspaceAnalyticsResponse_CreatorItem)(nil),    // 5: slash.api.v1.GetWorkspaceAnalyticsResponse.CreatorItem
+	(*timestamppb.Timestamp)(nil),                        // 6: google.protobuf.Timestamp
+}
+var file_api_v1_analytics_service_proto_depIdxs = []int32{
+	6, // 0: slash.api.v1.GetWorkspaceAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	6, // 1: slash.api.v1.GetWorkspaceAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	2, // 2: slash.api.v1.GetWorkspaceAnalyticsResponse.top_shortcuts:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutItem
+	3, // 3: slash.api.v1.GetWorkspaceAnalyticsResponse.top_referers:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.AnalyticsItem
+	4, // 4: slash.api.v1.GetWorkspaceAnalyticsResponse.views:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.TimeSeriesItem
+	4, // 5: slash.api.v1.GetWorkspaceAnalyticsResponse.new_shortcuts:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.TimeSeriesItem
+	5, // 6: slash.api.v1.GetWorkspaceAnalyticsResponse.top_creators:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.CreatorItem
+	6, // 7: slash.api.v1.GetWorkspaceAnalyticsResponse.TimeSeriesItem.time:type_name -> google.protobuf.Timestamp
+	0, // 8: slash.api.v1.AnalyticsService.GetWorkspaceAnalytics:input_type -> slash.api.v1.GetWorkspaceAnalyticsRequest
+	1, // 9: slash.api.v1.AnalyticsService.GetWorkspaceAnalytics:output_type -> slash.api.v1.GetWorkspaceAnalyticsResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_analytics_service_proto_init() }
+func file_api_v1_analytics_service_proto_init() {
+	if File_api_v1_analytics_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_analytics_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_analytics_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_analytics_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsResponse_ShortcutItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_analytics_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsResponse_AnalyticsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_analytics_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsResponse_TimeSeriesItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_analytics_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceAnalyticsResponse_CreatorItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1_analytics_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_analytics_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_analytics_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_analytics_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_analytics_service_proto = out.File
+	file_api_v1_analytics_service_proto_rawDesc = nil
+	file_api_v1_analytics_service_proto_goTypes = nil
+	file_api_v1_analytics_service_proto_depIdxs = nil
+}
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CollectionService_ListCollections_FullMethodName     = "/slash.api.v1.CollectionService/ListCollections"
-	CollectionService_GetCollection_FullMethodName       = "/slash.api.v1.CollectionService/GetCollection"
-	CollectionService_GetCollectionByName_FullMethodName = "/slash.api.v1.CollectionService/GetCollectionByName"
-	CollectionService_CreateCollection_FullMethodName    = "/slash.api.v1.CollectionService/CreateCollection"
-	CollectionService_UpdateCollection_FullMethodName    = "/slash.api.v1.CollectionService/UpdateCollection"
-	CollectionService_DeleteCollection_FullMethodName    = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_ListCollections_FullMethodName              = "/slash.api.v1.CollectionService/ListCollections"
+	CollectionService_GetCollection_FullMethodName                = "/slash.api.v1.CollectionService/GetCollection"
+	CollectionService_GetCollectionByName_FullMethodName          = "/slash.api.v1.CollectionService/GetCollectionByName"
+	CollectionService_CreateCollection_FullMethodName             = "/slash.api.v1.CollectionService/CreateCollection"
+	CollectionService_UpdateCollection_FullMethodName             = "/slash.api.v1.CollectionService/UpdateCollection"
+	CollectionService_DeleteCollection_FullMethodName             = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_RestoreCollection_FullMethodName            = "/slash.api.v1.CollectionService/RestoreCollection"
+	CollectionService_PurgeCollection_FullMethodName              = "/slash.api.v1.CollectionService/PurgeCollection"
+	CollectionService_ListCollectionCollaborators_FullMethodName  = "/slash.api.v1.CollectionService/ListCollectionCollaborators"
+	CollectionService_UpsertCollectionCollaborator_FullMethodName = "/slash.api.v1.CollectionService/UpsertCollectionCollaborator"
+	CollectionService_DeleteCollectionCollaborator_FullMethodName = "/slash.api.v1.CollectionService/DeleteCollectionCollaborator"
+	CollectionService_TransferCollectionOwnership_FullMethodName  = "/slash.api.v1.CollectionService/TransferCollectionOwnership"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
@@ -40,9 +46,22 @@ type CollectionServiceClient interface {
 	// CreateCollection creates a collection.
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
 	// UpdateCollection updates a collection.
+	// The editors can update it, but only its creator or an admin can change its visibility and groups.
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
-	// DeleteCollection deletes a collection by id.
+	// DeleteCollection moves a collection to the trash by id.
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	// RestoreCollection moves a collection out of the trash.
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error)
+	// PurgeCollection permanently deletes a collection in the trash.
+	PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*PurgeCollectionResponse, error)
+	// ListCollectionCollaborators returns the collaborators of a collection.
+	ListCollectionCollaborators(ctx context.Context, in *ListCollectionCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollectionCollaboratorsResponse, error)
+	// UpsertCollectionCollaborator adds a collaborator to a collection or changes their role.
+	UpsertCollectionCollaborator(ctx context.Context, in *UpsertCollectionCollaboratorRequest, opts ...grpc.CallOption) (*UpsertCollectionCollaboratorResponse, error)
+	// DeleteCollectionCollaborator removes a collaborator from a collection.
+	DeleteCollectionCollaborator(ctx context.Context, in *DeleteCollectionCollaboratorRequest, opts ...grpc.CallOption) (*DeleteCollectionCollaboratorResponse, error)
+	// TransferCollectionOwnership makes another active user the creator of a collection.
+	TransferCollectionOwnership(ctx context.Context, in *TransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*TransferCollectionOwnershipResponse, error)
 }
 
 type collectionServiceClient struct {
@@ -107,6 +126,60 @@ func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *collectionServiceClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error) {
+	out := new(RestoreCollectionResponse)
+	err := c.cc.Invoke(ctx, CollectionService_RestoreCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*PurgeCollectionResponse, error) {
+	out := new(PurgeCollectionResponse)
+	err := c.cc.Invoke(ctx, CollectionService_PurgeCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionCollaborators(ctx context.Context, in *ListCollectionCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollectionCollaboratorsResponse, error) {
+	out := new(ListCollectionCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionCollaborators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpsertCollectionCollaborator(ctx context.Context, in *UpsertCollectionCollaboratorRequest, opts ...grpc.CallOption) (*UpsertCollectionCollaboratorResponse, error) {
+	out := new(UpsertCollectionCollaboratorResponse)
+	err := c.cc.Invoke(ctx, CollectionService_UpsertCollectionCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteCollectionCollaborator(ctx context.Context, in *DeleteCollectionCollaboratorRequest, opts ...grpc.CallOption) (*DeleteCollectionCollaboratorResponse, error) {
+	out := new(DeleteCollectionCollaboratorResponse)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollectionCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) TransferCollectionOwnership(ctx context.Context, in *TransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*TransferCollectionOwnershipResponse, error) {
+	out := new(TransferCollectionOwnershipResponse)
+	err := c.cc.Invoke(ctx, CollectionService_TransferCollectionOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectionServiceServer is the server API for CollectionService service.
 // All implementations must embed UnimplementedCollectionServiceServer
 // for forward compatibility
@@ -120,9 +193,22 @@ type CollectionServiceServer interface {
 	// CreateCollection creates a collection.
 	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
 	// UpdateCollection updates a collection.
+	// The editors can update it, but only its creator or an admin can change its visibility and groups.
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
-	// DeleteCollection deletes a collection by id.
+	// DeleteCollection moves a collection to the trash by id.
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	// RestoreCollection moves a collection out of the trash.
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error)
+	// PurgeCollection permanently deletes a collection in the trash.
+	PurgeCollection(context.Context, *PurgeCollectionRequest) (*PurgeCollectionResponse, error)
+	// ListCollectionCollaborators returns the collaborators of a collection.
+	ListCollectionCollaborators(context.Context, *ListCollectionCollaboratorsRequest) (*ListCollectionCollaboratorsResponse, error)
+	// UpsertCollectionCollaborator adds a collaborator to a collection or changes their role.
+	UpsertCollectionCollaborator(context.Context, *UpsertCollectionCollaboratorRequest) (*UpsertCollectionCollaboratorResponse, error)
+	// DeleteCollectionCollaborator removes a collaborator from a collection.
+	DeleteCollectionCollaborator(context.Context, *DeleteCollectionCollaboratorRequest) (*DeleteCollectionCollaboratorResponse, error)
+	// TransferCollectionOwnership makes another active user the creator of a collection.
+	TransferCollectionOwnership(context.Context, *TransferCollectionOwnershipRequest) (*TransferCollectionOwnershipResponse, error)
 	mustEmbedUnimplementedCollectionServiceServer()
 }
 
@@ -148,6 +234,24 @@ func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *U
 func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedCollectionServiceServer) RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) PurgeCollection(context.Context, *PurgeCollectionRequest) (*PurgeCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionCollaborators(context.Context, *ListCollectionCollaboratorsRequest) (*ListCollectionCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionCollaborators not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpsertCollectionCollaborator(context.Context, *UpsertCollectionCollaboratorRequest) (*UpsertCollectionCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertCollectionCollaborator not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollectionCollaborator(context.Context, *DeleteCollectionCollaboratorRequest) (*DeleteCollectionCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionCollaborator not implemented")
+}
+func (UnimplementedCollectionServiceServer) TransferCollectionOwnership(context.Context, *TransferCollectionOwnershipRequest) (*TransferCollectionOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCollectionOwnership not implemented")
+}
 func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
 
 // UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +373,114 @@ func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RestoreCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_PurgeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).PurgeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_PurgeCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).PurgeCollection(ctx, req.(*PurgeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionCollaborators(ctx, req.(*ListCollectionCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpsertCollectionCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertCollectionCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpsertCollectionCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpsertCollectionCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpsertCollectionCollaborator(ctx, req.(*UpsertCollectionCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollectionCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollectionCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollectionCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollectionCollaborator(ctx, req.(*DeleteCollectionCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_TransferCollectionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCollectionOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).TransferCollectionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_TransferCollectionOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).TransferCollectionOwnership(ctx, req.(*TransferCollectionOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +512,30 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _CollectionService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _CollectionService_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "PurgeCollection",
+			Handler:    _CollectionService_PurgeCollection_Handler,
+		},
+		{
+			MethodName: "ListCollectionCollaborators",
+			Handler:    _CollectionService_ListCollectionCollaborators_Handler,
+		},
+		{
+			MethodName: "UpsertCollectionCollaborator",
+			Handler:    _CollectionService_UpsertCollectionCollaborator_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionCollaborator",
+			Handler:    _CollectionService_DeleteCollectionCollaborator_Handler,
+		},
+		{
+			MethodName: "TransferCollectionOwnership",
+			Handler:    _CollectionService_TransferCollectionOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/collection_service.proto",
@@ -19,13 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName        = "/slash.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_GetShortcutByName_FullMethodName    = "/slash.api.v1.ShortcutService/GetShortcutByName"
-	ShortcutService_CreateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/DeleteShortcut"
-	ShortcutService_GetShortcutAnalytics_FullMethodName = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcuts_FullMethodName              = "/slash.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_SearchShortcuts_FullMethodName            = "/slash.api.v1.ShortcutService/SearchShortcuts"
+	ShortcutService_GetShortcut_FullMethodName                = "/slash.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_GetShortcutByName_FullMethodName          = "/slash.api.v1.ShortcutService/GetShortcutByName"
+	ShortcutService_ListShortcutSuggestions_FullMethodName    = "/slash.api.v1.ShortcutService/ListShortcutSuggestions"
+	ShortcutService_CreateShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_ListTrash_FullMethodName                  = "/slash.api.v1.ShortcutService/ListTrash"
+	ShortcutService_RestoreShortcut_FullMethodName            = "/slash.api.v1.ShortcutService/RestoreShortcut"
+	ShortcutService_PurgeShortcut_FullMethodName              = "/slash.api.v1.ShortcutService/PurgeShortcut"
+	ShortcutService_ListShortcutRevisions_FullMethodName      = "/slash.api.v1.ShortcutService/ListShortcutRevisions"
+	ShortcutService_RevertShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/RevertShortcut"
+	ShortcutService_ImportShortcuts_FullMethodName            = "/slash.api.v1.ShortcutService/ImportShortcuts"
+	ShortcutService_ListShortcutCollaborators_FullMethodName  = "/slash.api.v1.ShortcutService/ListShortcutCollaborators"
+	ShortcutService_UpsertShortcutCollaborator_FullMethodName = "/slash.api.v1.ShortcutService/UpsertShortcutCollaborator"
+	ShortcutService_DeleteShortcutCollaborator_FullMethodName = "/slash.api.v1.ShortcutService/DeleteShortcutCollaborator"
+	ShortcutService_TransferShortcutOwnership_FullMethodName  = "/slash.api.v1.ShortcutService/TransferShortcutOwnership"
+	ShortcutService_GetShortcutAnalytics_FullMethodName       = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -34,17 +46,45 @@ const (
 type ShortcutServiceClient interface {
 	// ListShortcuts returns a list of shortcuts.
 	ListShortcuts(ctx context.Context, in *ListShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error)
+	// SearchShortcuts returns the shortcuts matching the query, sorted by relevance.
+	SearchShortcuts(ctx context.Context, in *SearchShortcutsRequest, opts ...grpc.CallOption) (*SearchShortcutsResponse, error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*GetShortcutResponse, error)
 	// GetShortcutByName returns a shortcut by name.
+	// If the shortcut does not exist, the NotFound error carries ShortcutSuggestion details.
 	GetShortcutByName(ctx context.Context, in *GetShortcutByNameRequest, opts ...grpc.CallOption) (*GetShortcutByNameResponse, error)
+	// ListShortcutSuggestions returns the visible shortcuts with names similar to a name which does not exist.
+	ListShortcutSuggestions(ctx context.Context, in *ListShortcutSuggestionsRequest, opts ...grpc.CallOption) (*ListShortcutSuggestionsResponse, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*CreateShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
+	// The editors can update it, but only its creator or an admin can change its visibility and groups.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*UpdateShortcutResponse, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash by id.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*DeleteShortcutResponse, error)
-	// GetShortcutAnalytics returns the analytics for a shortcut.
+	// ListTrash returns the shortcuts and collections in the trash.
+	// Admins get the trash of all users, and other users their own.
+	// The shortcuts archived at their expire time are not in the trash until they are deleted.
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// RestoreShortcut moves a shortcut out of the trash.
+	RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*RestoreShortcutResponse, error)
+	// PurgeShortcut permanently deletes a shortcut in the trash.
+	PurgeShortcut(ctx context.Context, in *PurgeShortcutRequest, opts ...grpc.CallOption) (*PurgeShortcutResponse, error)
+	// ListShortcutRevisions returns the edits of a shortcut, the most recent first.
+	ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error)
+	// RevertShortcut restores the shortcut to the state before the edit of a revision.
+	RevertShortcut(ctx context.Context, in *RevertShortcutRequest, opts ...grpc.CallOption) (*RevertShortcutResponse, error)
+	// ImportShortcuts creates shortcuts in bulk from a CSV, JSON or bookmark HTML file.
+	ImportShortcuts(ctx context.Context, in *ImportShortcutsRequest, opts ...grpc.CallOption) (*ImportShortcutsResponse, error)
+	// ListShortcutCollaborators returns the collaborators of a shortcut.
+	ListShortcutCollaborators(ctx context.Context, in *ListShortcutCollaboratorsRequest, opts ...grpc.CallOption) (*ListShortcutCollaboratorsResponse, error)
+	// UpsertShortcutCollaborator adds a collaborator to a shortcut or changes their role.
+	UpsertShortcutCollaborator(ctx context.Context, in *UpsertShortcutCollaboratorRequest, opts ...grpc.CallOption) (*UpsertShortcutCollaboratorResponse, error)
+	// DeleteShortcutCollaborator removes a collaborator from a shortcut.
+	DeleteShortcutCollaborator(ctx context.Context, in *DeleteShortcutCollaboratorRequest, opts ...grpc.CallOption) (*DeleteShortcutCollaboratorResponse, error)
+	// TransferShortcutOwnership makes another active user the creator of a shortcut.
+	TransferShortcutOwnership(ctx context.Context, in *TransferShortcutOwnershipRequest, opts ...grpc.CallOption) (*TransferShortcutOwnershipResponse, error)
+	// GetShortcutAnalytics returns the analytics for a shortcut visible to the user.
 	GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error)
 }
 
@@ -65,6 +105,15 @@ func (c *shortcutServiceClient) ListShortcuts(ctx context.Context, in *ListShort
 	return out, nil
 }
 
+func (c *shortcutServiceClient) SearchShortcuts(ctx context.Context, in *SearchShortcutsRequest, opts ...grpc.CallOption) (*SearchShortcutsResponse, error) {
+	out := new(SearchShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_SearchShortcuts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*GetShortcutResponse, error) {
 	out := new(GetShortcutResponse)
 	err := c.cc.Invoke(ctx, ShortcutService_GetShortcut_FullMethodName, in, out, opts...)
@@ -83,6 +132,15 @@ func (c *shortcutServiceClient) GetShortcutByName(ctx context.Context, in *GetSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ListShortcutSuggestions(ctx context.Context, in *ListShortcutSuggestionsRequest, opts ...grpc.CallOption) (*ListShortcutSuggestionsResponse, error) {
+	out := new(ListShortcutSuggestionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*CreateShortcutResponse, error) {
 	out := new(CreateShortcutResponse)
 	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcut_FullMethodName, in, out, opts...)
@@ -110,6 +168,96 @@ func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*RestoreShortcutResponse, error) {
+	out := new(RestoreShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_RestoreShortcut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) PurgeShortcut(ctx context.Context, in *PurgeShortcutRequest, opts ...grpc.CallOption) (*PurgeShortcutResponse, error) {
+	out := new(PurgeShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_PurgeShortcut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error) {
+	out := new(ListShortcutRevisionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RevertShortcut(ctx context.Context, in *RevertShortcutRequest, opts ...grpc.CallOption) (*RevertShortcutResponse, error) {
+	out := new(RevertShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_RevertShortcut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ImportShortcuts(ctx context.Context, in *ImportShortcutsRequest, opts ...grpc.CallOption) (*ImportShortcutsResponse, error) {
+	out := new(ImportShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ImportShortcuts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutCollaborators(ctx context.Context, in *ListShortcutCollaboratorsRequest, opts ...grpc.CallOption) (*ListShortcutCollaboratorsResponse, error) {
+	out := new(ListShortcutCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutCollaborators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) UpsertShortcutCollaborator(ctx context.Context, in *UpsertShortcutCollaboratorRequest, opts ...grpc.CallOption) (*UpsertShortcutCollaboratorResponse, error) {
+	out := new(UpsertShortcutCollaboratorResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_UpsertShortcutCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DeleteShortcutCollaborator(ctx context.Context, in *DeleteShortcutCollaboratorRequest, opts ...grpc.CallOption) (*DeleteShortcutCollaboratorResponse, error) {
+	out := new(DeleteShortcutCollaboratorResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_DeleteShortcutCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) TransferShortcutOwnership(ctx context.Context, in *TransferShortcutOwnershipRequest, opts ...grpc.CallOption) (*TransferShortcutOwnershipResponse, error) {
+	out := new(TransferShortcutOwnershipResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_TransferShortcutOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error) {
 	out := new(GetShortcutAnalyticsResponse)
 	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutAnalytics_FullMethodName, in, out, opts...)
@@ -125,17 +273,45 @@ func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *Ge
 type ShortcutServiceServer interface {
 	// ListShortcuts returns a list of shortcuts.
 	ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error)
+	// SearchShortcuts returns the shortcuts matching the query, sorted by relevance.
+	SearchShortcuts(context.Context, *SearchShortcutsRequest) (*SearchShortcutsResponse, error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(context.Context, *GetShortcutRequest) (*GetShortcutResponse, error)
 	// GetShortcutByName returns a shortcut by name.
+	// If the shortcut does not exist, the NotFound error carries ShortcutSuggestion details.
 	GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*GetShortcutByNameResponse, error)
+	// ListShortcutSuggestions returns the visible shortcuts with names similar to a name which does not exist.
+	ListShortcutSuggestions(context.Context, *ListShortcutSuggestionsRequest) (*ListShortcutSuggestionsResponse, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*CreateShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
+	// The editors can update it, but only its creator or an admin can change its visibility and groups.
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*UpdateShortcutResponse, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash by id.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*DeleteShortcutResponse, error)
-	// GetShortcutAnalytics returns the analytics for a shortcut.
+	// ListTrash returns the shortcuts and collections in the trash.
+	// Admins get the trash of all users, and other users their own.
+	// The shortcuts archived at their expire time are not in the trash until they are deleted.
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// RestoreShortcut moves a shortcut out of the trash.
+	RestoreShortcut(context.Context, *RestoreShortcutRequest) (*RestoreShortcutResponse, error)
+	// PurgeShortcut permanently deletes a shortcut in the trash.
+	PurgeShortcut(context.Context, *PurgeShortcutRequest) (*PurgeShortcutResponse, error)
+	// ListShortcutRevisions returns the edits of a shortcut, the most recent first.
+	ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error)
+	// RevertShortcut restores the shortcut to the state before the edit of a revision.
+	RevertShortcut(context.Context, *RevertShortcutRequest) (*RevertShortcutResponse, error)
+	// ImportShortcuts creates shortcuts in bulk from a CSV, JSON or bookmark HTML file.
+	ImportShortcuts(context.Context, *ImportShortcutsRequest) (*ImportShortcutsResponse, error)
+	// ListShortcutCollaborators returns the collaborators of a shortcut.
+	ListShortcutCollaborators(context.Context, *ListShortcutCollaboratorsRequest) (*ListShortcutCollaboratorsResponse, error)
+	// UpsertShortcutCollaborator adds a collaborator to a shortcut or changes their role.
+	UpsertShortcutCollaborator(context.Context, *UpsertShortcutCollaboratorRequest) (*UpsertShortcutCollaboratorResponse, error)
+	// DeleteShortcutCollaborator removes a collaborator from a shortcut.
+	DeleteShortcutCollaborator(context.Context, *DeleteShortcutCollaboratorRequest) (*DeleteShortcutCollaboratorResponse, error)
+	// TransferShortcutOwnership makes another active user the creator of a shortcut.
+	TransferShortcutOwnership(context.Context, *TransferShortcutOwnershipRequest) (*TransferShortcutOwnershipResponse, error)
+	// GetShortcutAnalytics returns the analytics for a shortcut visible to the user.
 	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
 	mustEmbedUnimplementedShortcutServiceServer()
 }
@@ -147,12 +323,18 @@ type UnimplementedShortcutServiceServer struct {
 func (UnimplementedShortcutServiceServer) ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListShortcuts not implemented")
 }
+func (UnimplementedShortcutServiceServer) SearchShortcuts(context.Context, *SearchShortcutsRequest) (*SearchShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchShortcuts not implemented")
+}
 func (UnimplementedShortcutServiceServer) GetShortcut(context.Context, *GetShortcutRequest) (*GetShortcutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcut not implemented")
 }
 func (UnimplementedShortcutServiceServer) GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*GetShortcutByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutByName not implemented")
 }
+func (UnimplementedShortcutServiceServer) ListShortcutSuggestions(context.Context, *ListShortcutSuggestionsRequest) (*ListShortcutSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutSuggestions not implemented")
+}
 func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *CreateShortcutRequest) (*CreateShortcutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcut not implemented")
 }
@@ -162,6 +344,36 @@ func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *Updat
 func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*DeleteShortcutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedShortcutServiceServer) RestoreShortcut(context.Context, *RestoreShortcutRequest) (*RestoreShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) PurgeShortcut(context.Context, *PurgeShortcutRequest) (*PurgeShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutRevisions not implemented")
+}
+func (UnimplementedShortcutServiceServer) RevertShortcut(context.Context, *RevertShortcutRequest) (*RevertShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) ImportShortcuts(context.Context, *ImportShortcutsRequest) (*ImportShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutCollaborators(context.Context, *ListShortcutCollaboratorsRequest) (*ListShortcutCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutCollaborators not implemented")
+}
+func (UnimplementedShortcutServiceServer) UpsertShortcutCollaborator(context.Context, *UpsertShortcutCollaboratorRequest) (*UpsertShortcutCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertShortcutCollaborator not implemented")
+}
+func (UnimplementedShortcutServiceServer) DeleteShortcutCollaborator(context.Context, *DeleteShortcutCollaboratorRequest) (*DeleteShortcutCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcutCollaborator not implemented")
+}
+func (UnimplementedShortcutServiceServer) TransferShortcutOwnership(context.Context, *TransferShortcutOwnershipRequest) (*TransferShortcutOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferShortcutOwnership not implemented")
+}
 func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
 }
@@ -196,6 +408,24 @@ func _ShortcutService_ListShortcuts_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_SearchShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).SearchShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_SearchShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).SearchShortcuts(ctx, req.(*SearchShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_GetShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShortcutRequest)
 	if err := dec(in); err != nil {
@@ -232,6 +462,24 @@ func _ShortcutService_GetShortcutByName_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ListShortcutSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutSuggestions(ctx, req.(*ListShortcutSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_CreateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShortcutRequest)
 	if err := dec(in); err != nil {
@@ -286,6 +534,186 @@ func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RestoreShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RestoreShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, req.(*RestoreShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_PurgeShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).PurgeShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_PurgeShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).PurgeShortcut(ctx, req.(*PurgeShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, req.(*ListShortcutRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RevertShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RevertShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RevertShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RevertShortcut(ctx, req.(*RevertShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ImportShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ImportShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ImportShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ImportShortcuts(ctx, req.(*ImportShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutCollaborators(ctx, req.(*ListShortcutCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_UpsertShortcutCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertShortcutCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).UpsertShortcutCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_UpsertShortcutCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).UpsertShortcutCollaborator(ctx, req.(*UpsertShortcutCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_DeleteShortcutCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShortcutCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DeleteShortcutCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DeleteShortcutCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DeleteShortcutCollaborator(ctx, req.(*DeleteShortcutCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_TransferShortcutOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferShortcutOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).TransferShortcutOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_TransferShortcutOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).TransferShortcutOwnership(ctx, req.(*TransferShortcutOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShortcutAnalyticsRequest)
 	if err := dec(in); err != nil {
@@ -315,6 +743,10 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListShortcuts",
 			Handler:    _ShortcutService_ListShortcuts_Handler,
 		},
+		{
+			MethodName: "SearchShortcuts",
+			Handler:    _ShortcutService_SearchShortcuts_Handler,
+		},
 		{
 			MethodName: "GetShortcut",
 			Handler:    _ShortcutService_GetShortcut_Handler,
@@ -323,6 +755,10 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShortcutByName",
 			Handler:    _ShortcutService_GetShortcutByName_Handler,
 		},
+		{
+			MethodName: "ListShortcutSuggestions",
+			Handler:    _ShortcutService_ListShortcutSuggestions_Handler,
+		},
 		{
 			MethodName: "CreateShortcut",
 			Handler:    _ShortcutService_CreateShortcut_Handler,
@@ -335,6 +771,46 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
 		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _ShortcutService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreShortcut",
+			Handler:    _ShortcutService_RestoreShortcut_Handler,
+		},
+		{
+			MethodName: "PurgeShortcut",
+			Handler:    _ShortcutService_PurgeShortcut_Handler,
+		},
+		{
+			MethodName: "ListShortcutRevisions",
+			Handler:    _ShortcutService_ListShortcutRevisions_Handler,
+		},
+		{
+			MethodName: "RevertShortcut",
+			Handler:    _ShortcutService_RevertShortcut_Handler,
+		},
+		{
+			MethodName: "ImportShortcuts",
+			Handler:    _ShortcutService_ImportShortcuts_Handler,
+		},
+		{
+			MethodName: "ListShortcutCollaborators",
+			Handler:    _ShortcutService_ListShortcutCollaborators_Handler,
+		},
+		{
+			MethodName: "UpsertShortcutCollaborator",
+			Handler:    _ShortcutService_UpsertShortcutCollaborator_Handler,
+		},
+		{
+			MethodName: "DeleteShortcutCollaborator",
+			Handler:    _ShortcutService_DeleteShortcutCollaborator_Handler,
+		},
+		{
+			MethodName: "TransferShortcutOwnership",
+			Handler:    _ShortcutService_TransferShortcutOwnership_Handler,
+		},
 		{
 			MethodName: "GetShortcutAnalytics",
 			Handler:    _ShortcutService_GetShortcutAnalytics_Handler,
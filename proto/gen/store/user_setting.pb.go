@@ -187,7 +187,6 @@ type UserSetting struct {
 	UserId int32          `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Key    UserSettingKey `protobuf:"varint,2,opt,name=key,proto3,enum=slash.store.UserSettingKey" json:"key,omitempty"`
 	// Types that are assignable to Value:
-	//
 	//	*UserSetting_AccessTokens
 	//	*UserSetting_Locale
 	//	*UserSetting_ColorTheme
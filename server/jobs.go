@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// defaultTrashRetentionDays is the number of days the deleted shortcuts and collections
+// are kept in the trash when the workspace does not configure it.
+const defaultTrashRetentionDays = 30
+
+// registerCronJobs registers the background jobs running on the server cron scheduler.
+func (s *Server) registerCronJobs(ctx context.Context) {
+	// Archive the expired shortcuts every minute.
+	s.cron.MustAdd("archive-expired-shortcuts", "* * * * *", func() {
+		if err := s.archiveExpiredShortcuts(ctx); err != nil {
+			slog.Error("failed to archive expired shortcuts", slog.Any("error", err))
+		}
+	})
+	// Purge the shortcuts and collections which stayed in the trash longer than the retention every hour.
+	s.cron.MustAdd("purge-trash", "0 * * * *", func() {
+		if err := s.purgeTrash(ctx); err != nil {
+			slog.Error("failed to purge trash", slog.Any("error", err))
+		}
+	})
+	// Back up the database as configured in the auto backup setting.
+	// An invalid setting should not prevent the server from starting.
+	if err := s.backupService.RegisterAutoBackup(ctx); err != nil {
+		slog.Error("failed to register auto backup", slog.Any("error", err))
+	}
+}
+
+// archiveExpiredShortcuts sets the row status of the shortcuts that passed their expire time to archived.
+// Archived shortcuts are not in the trash: they keep resolving to the expired response or their fallback link,
+// and are purged only once they are deleted to the trash like the other shortcuts.
+// The shortcuts in the trash are not archived until they are restored.
+// Updating the expire time of an archived shortcut to the future sets it back to normal.
+func (s *Server) archiveExpiredShortcuts(ctx context.Context) error {
+	rowStatus := store.Normal
+	now := time.Now().Unix()
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:     &rowStatus,
+		ExpiredBefore: &now,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list expired shortcuts")
+	}
+
+	archived := store.Archived
+	for _, shortcut := range shortcuts {
+		if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &archived,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to archive shortcut %d", shortcut.Id)
+		}
+	}
+	return nil
+}
+
+// purgeTrash permanently deletes the shortcuts and collections which were moved to the trash
+// before the trash retention days, whatever their row status.
+func (s *Server) purgeTrash(ctx context.Context) error {
+	retentionDays := int32(defaultTrashRetentionDays)
+	retentionSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get trash retention setting")
+	}
+	if retentionSetting != nil && retentionSetting.GetTrashRetentionDays() > 0 {
+		retentionDays = retentionSetting.GetTrashRetentionDays()
+	}
+	deletedBefore := time.Now().Add(-time.Duration(retentionDays) * 24 * time.Hour).Unix()
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list deleted shortcuts")
+	}
+	for _, shortcut := range shortcuts {
+		if shortcut.DeletedTs > deletedBefore {
+			continue
+		}
+		if err := s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+			ID: shortcut.Id,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to purge shortcut %d", shortcut.Id)
+		}
+	}
+
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list deleted collections")
+	}
+	for _, collection := range collections {
+		if collection.DeletedTs > deletedBefore {
+			continue
+		}
+		if err := s.Store.DeleteCollection(ctx, &store.DeleteCollection{
+			ID: collection.Id,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to purge collection %d", collection.Id)
+		}
+	}
+	return nil
+}
@@ -24,12 +24,15 @@ type Profile struct {
 	// DSN points to where slash stores its own data
 	DSN string `json:"-"`
 	// Driver is the database driver
-	// sqlite, mysql
+	// sqlite, postgres, mysql
 	Driver string `json:"-"`
 	// Version is the current version of server
 	Version string `json:"version"`
 	// Metric indicate the metric collection is enabled or not
 	Metric bool `json:"-"`
+	// MigrationBackupRetention is the number of the most recent SQLite backups before migration which are kept.
+	// All the backups are kept when it is 0.
+	MigrationBackupRetention int `json:"-" mapstructure:"migration_backup_retention"`
 }
 
 func (p *Profile) IsDev() bool {
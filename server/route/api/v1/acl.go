@@ -42,6 +42,25 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 
 // AuthenticationInterceptor is the unary interceptor for gRPC API.
 func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	childCtx, err := in.authenticateMethod(ctx, serverInfo.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(childCtx, request)
+}
+
+// AuthenticationStreamInterceptor is the stream interceptor for gRPC API.
+func (in *GRPCAuthInterceptor) AuthenticationStreamInterceptor(srv any, serverStream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	childCtx, err := in.authenticateMethod(serverStream.Context(), serverInfo.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedServerStream{ServerStream: serverStream, ctx: childCtx})
+}
+
+// authenticateMethod checks that the caller is allowed to call the method,
+// and returns the context with the id of the authenticated user.
+func (in *GRPCAuthInterceptor) authenticateMethod(ctx context.Context, fullMethod string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
@@ -51,10 +70,10 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get access token from metadata: %v", err)
 	}
 
-	userID, err := in.authenticate(ctx, accessToken)
+	userID, err := in.Authenticate(ctx, accessToken)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
+		if isUnauthorizeAllowedMethod(fullMethod) {
+			return ctx, nil
 		}
 		return nil, err
 	}
@@ -67,16 +86,26 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleAdmin {
+	if isOnlyForAdminAllowedMethod(fullMethod) && user.Role != store.RoleAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID %q is not admin", userID)
 	}
 
 	// Stores userID into context.
-	childCtx := context.WithValue(ctx, userIDContextKey, userID)
-	return handler(childCtx, request)
+	return context.WithValue(ctx, userIDContextKey, userID), nil
+}
+
+// authenticatedServerStream overrides the context of a server stream with the authenticated one.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
 }
 
-func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (int32, error) {
+// Authenticate validates the access token and returns the id of the user it belongs to.
+func (in *GRPCAuthInterceptor) Authenticate(ctx context.Context, accessToken string) (int32, error) {
 	if accessToken == "" {
 		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
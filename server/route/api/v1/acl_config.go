@@ -3,15 +3,16 @@ package v1
 import "strings"
 
 var allowedMethodsWhenUnauthorized = map[string]bool{
-	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":  true,
-	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":  true,
-	"/slash.api.v1.AuthService/SignIn":                    true,
-	"/slash.api.v1.AuthService/SignUp":                    true,
-	"/slash.api.v1.AuthService/SignOut":                   true,
-	"/slash.api.v1.AuthService/GetAuthStatus":             true,
-	"/slash.api.v1.ShortcutService/GetShortcutByName":     true,
-	"/slash.api.v1.ShortcutService/GetShortcut":           true,
-	"/slash.api.v1.CollectionService/GetCollectionByName": true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":    true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":    true,
+	"/slash.api.v1.AuthService/SignIn":                      true,
+	"/slash.api.v1.AuthService/SignUp":                      true,
+	"/slash.api.v1.AuthService/SignOut":                     true,
+	"/slash.api.v1.AuthService/GetAuthStatus":               true,
+	"/slash.api.v1.ShortcutService/GetShortcutByName":       true,
+	"/slash.api.v1.ShortcutService/GetShortcut":             true,
+	"/slash.api.v1.ShortcutService/ListShortcutSuggestions": true,
+	"/slash.api.v1.CollectionService/GetCollectionByName":   true,
 }
 
 // isUnauthorizeAllowedMethod returns true if the method is allowed to be called when the user is not authorized.
@@ -25,8 +26,19 @@ func isUnauthorizeAllowedMethod(methodName string) bool {
 var allowedMethodsOnlyForAdmin = map[string]bool{
 	"/slash.api.v1.UserService/CreateUser":                  true,
 	"/slash.api.v1.UserService/DeleteUser":                  true,
+	"/slash.api.v1.UserService/TransferUserResources":       true,
 	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
+	"/slash.api.v1.WorkspaceService/ExportWorkspace":        true,
 	"/slash.api.v1.SubscriptionService/UpdateSubscription":  true,
+	"/slash.api.v1.BackupService/ListBackups":               true,
+	"/slash.api.v1.BackupService/CreateBackup":              true,
+	"/slash.api.v1.BackupService/DownloadBackup":            true,
+	"/slash.api.v1.BackupService/RestoreBackup":             true,
+	"/slash.api.v1.GroupService/CreateGroup":                true,
+	"/slash.api.v1.GroupService/UpdateGroup":                true,
+	"/slash.api.v1.GroupService/DeleteGroup":                true,
+	"/slash.api.v1.GroupService/AddGroupMember":             true,
+	"/slash.api.v1.GroupService/RemoveGroupMember":          true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+const (
+	secondsPerHour = 60 * 60
+	secondsPerDay  = 24 * secondsPerHour
+	secondsPerWeek = 7 * secondsPerDay
+
+	// maxTimeSeriesBucketCount is the maximum number of buckets in a time series.
+	maxTimeSeriesBucketCount = 10000
+	// defaultHourlyRangeSeconds is the time range of the hourly time series without a start time.
+	defaultHourlyRangeSeconds = 7 * secondsPerDay
+)
+
+// getBucketSeconds returns the size in seconds of a bucket of the granularity.
+func getBucketSeconds(granularity v1pb.Granularity) int64 {
+	switch granularity {
+	case v1pb.Granularity_HOUR:
+		return secondsPerHour
+	case v1pb.Granularity_WEEK:
+		return secondsPerWeek
+	default:
+		return secondsPerDay
+	}
+}
+
+// truncateToBucket returns the start time of the bucket of the granularity that contains the timestamp.
+// Buckets are aligned in UTC, and weeks start on Monday.
+func truncateToBucket(ts int64, granularity v1pb.Granularity) int64 {
+	size := getBucketSeconds(granularity)
+	offset := int64(0)
+	if granularity == v1pb.Granularity_WEEK {
+		// The unix epoch is a Thursday, and 1970-01-05 is the first Monday.
+		offset = 4 * secondsPerDay
+	}
+	return ts - ((ts-offset)%size+size)%size
+}
+
+// getTimeSeriesBuckets returns the start times of every bucket between the start and end time.
+// If startTs is nil, the buckets start from the first bucket with a count.
+func getTimeSeriesBuckets(countMap map[int64]int32, startTs *int64, endTs int64, granularity v1pb.Granularity) ([]int64, error) {
+	buckets := []int64{}
+	if startTs == nil && len(countMap) == 0 {
+		return buckets, nil
+	}
+
+	var first int64
+	if startTs != nil {
+		first = truncateToBucket(*startTs, granularity)
+	} else {
+		first = endTs
+		for ts := range countMap {
+			first = min(first, ts)
+		}
+	}
+	last := truncateToBucket(endTs-1, granularity)
+	size := getBucketSeconds(granularity)
+	if (last-first)/size+1 > maxTimeSeriesBucketCount {
+		return nil, status.Errorf(codes.InvalidArgument, "time range is too large for the granularity")
+	}
+	for ts := first; ts <= last; ts += size {
+		buckets = append(buckets, ts)
+	}
+	return buckets, nil
+}
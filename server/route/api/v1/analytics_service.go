@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	defaultWorkspaceAnalyticsDays  = 30
+	defaultWorkspaceAnalyticsLimit = 10
+)
+
+func (s *APIV1Service) GetWorkspaceAnalytics(ctx context.Context, request *v1pb.GetWorkspaceAnalyticsRequest) (*v1pb.GetWorkspaceAnalyticsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	endTs := time.Now().Unix()
+	if request.EndTime != nil {
+		endTs = request.EndTime.AsTime().Unix()
+	}
+	startTs := endTs - defaultWorkspaceAnalyticsDays*secondsPerDay
+	if request.StartTime != nil {
+		startTs = request.StartTime.AsTime().Unix()
+	}
+	if startTs >= endTs {
+		return nil, status.Errorf(codes.InvalidArgument, "start time must be before end time")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultWorkspaceAnalyticsLimit
+	}
+
+	// Only count the shortcuts visible to the current user, while admins can see all of them.
+	find := &store.FindShortcut{}
+	if user.Role != store.RoleAdmin {
+		find.ViewerID = &user.ID
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	shortcutMap := make(map[int32]*storepb.Shortcut)
+	for _, shortcut := range shortcuts {
+		shortcutMap[shortcut.Id] = shortcut
+	}
+
+	dayTsAfter := store.GetDayTs(startTs)
+	stats, err := s.Store.ListShortcutViewStats(ctx, &store.FindShortcutViewStat{
+		DayTsAfter:  &dayTsAfter,
+		DayTsBefore: &endTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut view stats, err: %v", err)
+	}
+	shortcutViewMap := make(map[int32]int32)
+	refererMap := make(map[string]int32)
+	viewMap := make(map[int64]int32)
+	for _, stat := range stats {
+		if _, ok := shortcutMap[stat.ShortcutID]; !ok {
+			continue
+		}
+		shortcutViewMap[stat.ShortcutID] += stat.Count
+		refererMap[stat.Referer] += stat.Count
+		viewMap[stat.DayTs] += stat.Count
+	}
+
+	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
+		Type:            store.ActivityShortcutCreate,
+		CreatedTsAfter:  &startTs,
+		CreatedTsBefore: &endTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+	}
+	creatorMap := make(map[int32]int32)
+	newShortcutMap := make(map[int64]int32)
+	for _, activity := range activities {
+		payload := &storepb.ActivityShorcutCreatePayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal payload, err: %v", err)
+		}
+		if _, ok := shortcutMap[payload.ShortcutId]; !ok {
+			continue
+		}
+		creatorMap[activity.CreatorID]++
+		newShortcutMap[store.GetDayTs(activity.CreatedTs)]++
+	}
+
+	response := &v1pb.GetWorkspaceAnalyticsResponse{
+		TopShortcuts: []*v1pb.GetWorkspaceAnalyticsResponse_ShortcutItem{},
+		TopReferers:  []*v1pb.GetWorkspaceAnalyticsResponse_AnalyticsItem{},
+		Views:        []*v1pb.GetWorkspaceAnalyticsResponse_TimeSeriesItem{},
+		NewShortcuts: []*v1pb.GetWorkspaceAnalyticsResponse_TimeSeriesItem{},
+		TopCreators:  []*v1pb.GetWorkspaceAnalyticsResponse_CreatorItem{},
+	}
+	for shortcutID, count := range shortcutViewMap {
+		response.TopShortcuts = append(response.TopShortcuts, &v1pb.GetWorkspaceAnalyticsResponse_ShortcutItem{
+			ShortcutId: shortcutID,
+			Name:       shortcutMap[shortcutID].Name,
+			Count:      count,
+		})
+	}
+	slices.SortFunc(response.TopShortcuts, func(i, j *v1pb.GetWorkspaceAnalyticsResponse_ShortcutItem) int {
+		if i.Count != j.Count {
+			return int(j.Count - i.Count)
+		}
+		return strings.Compare(i.Name, j.Name)
+	})
+	response.TopShortcuts = response.TopShortcuts[:min(limit, len(response.TopShortcuts))]
+
+	for referer, count := range refererMap {
+		response.TopReferers = append(response.TopReferers, &v1pb.GetWorkspaceAnalyticsResponse_AnalyticsItem{
+			Name:  referer,
+			Count: count,
+		})
+	}
+	slices.SortFunc(response.TopReferers, func(i, j *v1pb.GetWorkspaceAnalyticsResponse_AnalyticsItem) int {
+		if i.Count != j.Count {
+			return int(j.Count - i.Count)
+		}
+		return strings.Compare(i.Name, j.Name)
+	})
+	response.TopReferers = response.TopReferers[:min(limit, len(response.TopReferers))]
+
+	buckets, err := getTimeSeriesBuckets(viewMap, &startTs, endTs, v1pb.Granularity_DAY)
+	if err != nil {
+		return nil, err
+	}
+	for _, bucket := range buckets {
+		response.Views = append(response.Views, &v1pb.GetWorkspaceAnalyticsResponse_TimeSeriesItem{
+			Time:  timestamppb.New(time.Unix(bucket, 0)),
+			Count: viewMap[bucket],
+		})
+		response.NewShortcuts = append(response.NewShortcuts, &v1pb.GetWorkspaceAnalyticsResponse_TimeSeriesItem{
+			Time:  timestamppb.New(time.Unix(bucket, 0)),
+			Count: newShortcutMap[bucket],
+		})
+	}
+
+	for creatorID, count := range creatorMap {
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &creatorID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user, err: %v", err)
+		}
+		if creator == nil {
+			continue
+		}
+		response.TopCreators = append(response.TopCreators, &v1pb.GetWorkspaceAnalyticsResponse_CreatorItem{
+			Creator:  fmt.Sprintf("%s%d", UserNamePrefix, creator.ID),
+			Nickname: creator.Nickname,
+			Count:    count,
+		})
+	}
+	slices.SortFunc(response.TopCreators, func(i, j *v1pb.GetWorkspaceAnalyticsResponse_CreatorItem) int {
+		if i.Count != j.Count {
+			return int(j.Count - i.Count)
+		}
+		return strings.Compare(i.Creator, j.Creator)
+	})
+	response.TopCreators = response.TopCreators[:min(limit, len(response.TopCreators))]
+
+	metric.Enqueue("workspace analytics")
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+func TestGetBucketSeconds(t *testing.T) {
+	require.Equal(t, int64(60*60), getBucketSeconds(v1pb.Granularity_HOUR))
+	require.Equal(t, int64(24*60*60), getBucketSeconds(v1pb.Granularity_DAY))
+	require.Equal(t, int64(7*24*60*60), getBucketSeconds(v1pb.Granularity_WEEK))
+	// Unspecified granularities default to days.
+	require.Equal(t, int64(24*60*60), getBucketSeconds(v1pb.Granularity_GRANULARITY_UNSPECIFIED))
+}
+
+func TestTruncateToBucket(t *testing.T) {
+	// 2024-01-03 is a Wednesday.
+	ts := time.Date(2024, 1, 3, 10, 30, 15, 0, time.UTC).Unix()
+	tests := []struct {
+		ts          int64
+		granularity v1pb.Granularity
+		want        time.Time
+	}{
+		{
+			ts:          ts,
+			granularity: v1pb.Granularity_HOUR,
+			want:        time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			ts:          ts,
+			granularity: v1pb.Granularity_DAY,
+			want:        time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ts:          ts,
+			granularity: v1pb.Granularity_WEEK,
+			want:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ts:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			granularity: v1pb.Granularity_WEEK,
+			want:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ts:          0,
+			granularity: v1pb.Granularity_WEEK,
+			want:        time.Date(1969, 12, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ts:          -1,
+			granularity: v1pb.Granularity_DAY,
+			want:        time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want.Unix(), truncateToBucket(test.ts, test.granularity), "ts %d, granularity %s", test.ts, test.granularity)
+	}
+}
+
+func TestGetTimeSeriesBuckets(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	day2 := day1 + secondsPerDay
+	day3 := day2 + secondsPerDay
+
+	// Without a start time nor counts, the series is empty.
+	buckets, err := getTimeSeriesBuckets(map[int64]int32{}, nil, day3, v1pb.Granularity_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []int64{}, buckets)
+
+	// The start time is truncated to its bucket, and the end time is exclusive.
+	startTs := day1 + secondsPerHour
+	buckets, err = getTimeSeriesBuckets(map[int64]int32{}, &startTs, day3, v1pb.Granularity_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []int64{day1, day2}, buckets)
+
+	// Without a start time, the series starts from the first bucket with a count.
+	buckets, err = getTimeSeriesBuckets(map[int64]int32{day2: 1}, nil, day3+1, v1pb.Granularity_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []int64{day2, day3}, buckets)
+
+	buckets, err = getTimeSeriesBuckets(map[int64]int32{}, &startTs, day1+4*secondsPerHour, v1pb.Granularity_HOUR)
+	require.NoError(t, err)
+	require.Equal(t, []int64{day1 + secondsPerHour, day1 + 2*secondsPerHour, day1 + 3*secondsPerHour}, buckets)
+
+	// Too many buckets are rejected.
+	startTs = 0
+	_, err = getTimeSeriesBuckets(map[int64]int32{}, &startTs, (maxTimeSeriesBucketCount+1)*secondsPerHour, v1pb.Granularity_HOUR)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -3,8 +3,10 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,8 @@ import (
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
@@ -32,21 +36,28 @@ func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusR
 }
 
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.SignInResponse, error) {
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Email: &request.Email,
-	})
+	user, err := s.signInWithLDAP(ctx, request.Email, request.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to find user by email %s", request.Email))
+		return nil, err
 	}
+	// The users not found in the directory, or not linked to it while it fails, sign in with their local password.
 	if user == nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("user not found with email %s", request.Email))
-	} else if user.RowStatus == store.Archived {
-		return nil, status.Errorf(codes.PermissionDenied, fmt.Sprintf("user has been archived with email %s", request.Email))
-	}
+		user, err = s.Store.GetUser(ctx, &store.FindUser{
+			Email: &request.Email,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to find user by email %s", request.Email))
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("user not found with email %s", request.Email))
+		} else if user.RowStatus == store.Archived {
+			return nil, status.Errorf(codes.PermissionDenied, fmt.Sprintf("user has been archived with email %s", request.Email))
+		}
 
-	// Compare the stored hashed password, with the hashed version of the password that was received.
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "unmatched email and password")
+		// Compare the stored hashed password, with the hashed version of the password that was received.
+		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unmatched email and password")
+		}
 	}
 
 	accessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(AccessTokenDuration), []byte(s.Secret))
@@ -146,3 +157,85 @@ func (*APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*v1pb
 
 	return &v1pb.SignOutResponse{}, nil
 }
+
+// signInWithLDAP returns the user of the directory entry of the login if the LDAP authentication is enabled,
+// and nil if it is disabled, or the login is not found in the directory or the directory fails
+// for a user who never signed in with it.
+func (s *APIV1Service) signInWithLDAP(ctx context.Context, login, password string) (*store.User, error) {
+	ldapSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
+	}
+	if !ldapSetting.GetLdap().GetEnabled() {
+		return nil, nil
+	}
+	authenticator, err := ldap.NewAuthenticator(ldapSetting.GetLdap())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid ldap setting, err: %s", err)
+	}
+
+	userInfo, err := authenticator.Authenticate(login, password)
+	if err != nil {
+		if errors.Is(err, ldap.ErrUserNotFound) {
+			// The users linked to the directory cannot sign in with their local password
+			// once they are removed from it.
+			linked, linkErr := s.isLinkedToLDAP(ctx, login)
+			if linkErr != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user identity, err: %s", linkErr)
+			}
+			if linked {
+				return nil, status.Errorf(codes.PermissionDenied, "user has been removed from the directory with login %s", login)
+			}
+			return nil, nil
+		} else if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.InvalidArgument, "unmatched email and password")
+		} else if errors.Is(err, ldap.ErrUserNotAllowed) {
+			return nil, status.Errorf(codes.PermissionDenied, "user is not allowed to sign in")
+		}
+		// The users who never signed in with the directory, e.g. the local admins, keep signing in
+		// with their local password while the directory is unavailable.
+		linked, linkErr := s.isLinkedToLDAP(ctx, login)
+		if linkErr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user identity, err: %s", linkErr)
+		}
+		if !linked {
+			slog.Warn("failed to authenticate with ldap, falling back to the local password", slog.Any("error", err))
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to authenticate with ldap, err: %s", err)
+	}
+	user, err := s.IdentityProviderService.SignIn(ctx, authenticator.IdentityProvider(), userInfo)
+	if err != nil {
+		if errors.Is(err, idp.ErrUserArchived) {
+			return nil, status.Errorf(codes.PermissionDenied, "user has been archived with login %s", login)
+		} else if errors.Is(err, idp.ErrEmailTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "failed to sync the email of the directory, err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to sign in with ldap, err: %s", err)
+	}
+	return user, nil
+}
+
+// isLinkedToLDAP returns whether the user with the email has signed in with the directory.
+func (s *APIV1Service) isLinkedToLDAP(ctx context.Context, email string) (bool, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &email,
+	})
+	if err != nil {
+		return false, err
+	}
+	if user == nil {
+		return false, nil
+	}
+	providerID := ldap.ProviderID
+	identities, err := s.Store.ListUserIdentities(ctx, &store.FindUserIdentity{
+		UserID:     &user.ID,
+		ProviderID: &providerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(identities) > 0, nil
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap/ldaptest"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+// serverTransportStream is the transport stream of the unary calls without a grpc server,
+// which accepts the headers.
+type serverTransportStream struct{}
+
+func (serverTransportStream) Method() string               { return "" }
+func (serverTransportStream) SetHeader(metadata.MD) error  { return nil }
+func (serverTransportStream) SendHeader(metadata.MD) error { return nil }
+func (serverTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestSignInWithLDAPFallback(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), serverTransportStream{})
+	service := newTestingService(ctx, t)
+	ts := service.Store
+	server, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:       "cn=slash,ou=services,dc=example,dc=com",
+			Password: "service-secret",
+		},
+		&ldaptest.Entry{
+			DN: "uid=jane,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"uid":  {"jane"},
+				"mail": {"jane@example.com"},
+			},
+			Password: "jane-secret",
+		},
+	)
+	require.NoError(t, err)
+	defer server.Close()
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP,
+		Value: &storepb.WorkspaceSetting_Ldap{Ldap: &storepb.LDAPWorkspaceSetting{
+			Enabled:      true,
+			Url:          server.URL(),
+			BindDn:       "cn=slash,ou=services,dc=example,dc=com",
+			BindPassword: "service-secret",
+			BaseDn:       "ou=people,dc=example,dc=com",
+		}},
+	})
+	require.NoError(t, err)
+
+	// The local admin is not in the directory, and John was linked to it before being removed.
+	admin := createTestingUser(ctx, t, ts, store.RoleAdmin, "admin@example.com", "admin-secret")
+	john := createTestingUser(ctx, t, ts, store.RoleUser, "john@example.com", "john-secret")
+	_, err = ts.CreateUserIdentity(ctx, &store.UserIdentity{
+		UserID:     john.ID,
+		ProviderID: ldap.ProviderID,
+		Subject:    "uid=john,ou=people,dc=example,dc=com",
+	})
+	require.NoError(t, err)
+
+	// Jane signs in with the directory, which links her user.
+	response, err := service.SignIn(ctx, &v1pb.SignInRequest{Email: "jane@example.com", Password: "jane-secret"})
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", response.User.Email)
+
+	// Not found in the directory and unlinked: the local password is used.
+	response, err = service.SignIn(ctx, &v1pb.SignInRequest{Email: admin.Email, Password: "admin-secret"})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, response.User.Id)
+	// Not found in the directory and linked: the local password is not used.
+	_, err = service.SignIn(ctx, &v1pb.SignInRequest{Email: john.Email, Password: "john-secret"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	server.Close()
+	// The directory is down and unlinked: the local password is used.
+	response, err = service.SignIn(ctx, &v1pb.SignInRequest{Email: admin.Email, Password: "admin-secret"})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, response.User.Id)
+	// The directory is down and linked: the sign-in is unavailable.
+	_, err = service.SignIn(ctx, &v1pb.SignInRequest{Email: "jane@example.com", Password: "jane-secret"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = service.SignIn(ctx, &v1pb.SignInRequest{Email: john.Email, Password: "john-secret"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// newTestingService returns the service of a testing store, without a grpc server.
+func newTestingService(ctx context.Context, t *testing.T) *APIV1Service {
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	licenseService := license.NewLicenseService(profile, ts)
+	return &APIV1Service{
+		Secret:                  "secret",
+		Profile:                 profile,
+		Store:                   ts,
+		LicenseService:          licenseService,
+		IdentityProviderService: idp.NewIdentityProviderService(ts, licenseService),
+	}
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, role store.Role, email, password string) *store.User {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:         role,
+		Email:        email,
+		Nickname:     email,
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	return user
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/server/service/backup"
+)
+
+// backupChunkSize is the size of the chunks of a downloaded backup.
+const backupChunkSize = 64 * 1024
+
+func (s *APIV1Service) ListBackups(_ context.Context, _ *v1pb.ListBackupsRequest) (*v1pb.ListBackupsResponse, error) {
+	backups, err := s.BackupService.ListBackups()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list backups: %v", err)
+	}
+	response := &v1pb.ListBackupsResponse{
+		Backups: []*v1pb.Backup{},
+	}
+	for _, item := range backups {
+		response.Backups = append(response.Backups, convertBackupFromService(item))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateBackup(ctx context.Context, _ *v1pb.CreateBackupRequest) (*v1pb.CreateBackupResponse, error) {
+	created, err := s.BackupService.CreateBackup(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create backup: %v", err)
+	}
+	return &v1pb.CreateBackupResponse{
+		Backup: convertBackupFromService(created),
+	}, nil
+}
+
+func (s *APIV1Service) DownloadBackup(request *v1pb.DownloadBackupRequest, stream v1pb.BackupService_DownloadBackupServer) error {
+	path, err := s.BackupService.GetBackupPath(request.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid backup name: %v", err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return status.Errorf(codes.NotFound, "backup not found")
+		}
+		return status.Errorf(codes.Internal, "failed to open backup: %v", err)
+	}
+	defer file.Close()
+
+	buffer := make([]byte, backupChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			if err := stream.Send(&v1pb.DownloadBackupResponse{Chunk: buffer[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read backup: %v", err)
+		}
+	}
+}
+
+func (s *APIV1Service) RestoreBackup(ctx context.Context, request *v1pb.RestoreBackupRequest) (*v1pb.RestoreBackupResponse, error) {
+	path, err := s.BackupService.GetBackupPath(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid backup name: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Errorf(codes.NotFound, "backup not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to stat backup: %v", err)
+	}
+	previousBackup, err := s.BackupService.RestoreBackup(ctx, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore backup: %v", err)
+	}
+	// The owner may be different in the restored data.
+	resetInstanceOwnerCache()
+	return &v1pb.RestoreBackupResponse{
+		PreviousBackup: convertBackupFromService(previousBackup),
+	}, nil
+}
+
+func convertBackupFromService(item *backup.Backup) *v1pb.Backup {
+	return &v1pb.Backup{
+		Name:       item.Name,
+		Size:       item.Size,
+		CreateTime: timestamppb.New(item.CreatedTime),
+	}
+}
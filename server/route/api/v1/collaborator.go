@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// canEditResource returns true if the user can update or delete a shortcut or a collection,
+// i.e. the user is an admin, its creator or one of its editors.
+func canEditResource(ctx context.Context, s *store.Store, user *store.User, resourceType store.CollaboratorResourceType, resourceID, creatorID int32) (bool, error) {
+	if user.Role == store.RoleAdmin || user.ID == creatorID {
+		return true, nil
+	}
+	collaborator, err := s.GetCollaborator(ctx, &store.FindCollaborator{
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+		UserID:       &user.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return collaborator != nil && collaborator.Role == store.CollaboratorRoleEditor, nil
+}
+
+// canShareResource returns true if the user can change the visibility and the groups of a shortcut or a collection,
+// i.e. the user is an admin or its creator. The editors cannot change who the resource is shared with.
+func canShareResource(user *store.User, creatorID int32) bool {
+	return user.Role == store.RoleAdmin || user.ID == creatorID
+}
+
+// isSharingChanged reports whether the visibility or the groups differ from the current ones of a resource.
+func isSharingChanged(visibility store.Visibility, groupIDs []int32, currentVisibility storepb.Visibility, currentGroupIDs []int32) bool {
+	if visibility != store.Visibility(currentVisibility.String()) || len(groupIDs) != len(currentGroupIDs) {
+		return true
+	}
+	for _, groupID := range groupIDs {
+		if !slices.Contains(currentGroupIDs, groupID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *APIV1Service) listCollaborators(ctx context.Context, resourceType store.CollaboratorResourceType, resourceID int32) ([]*v1pb.Collaborator, error) {
+	collaborators, err := s.Store.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collaborators, err: %v", err)
+	}
+	collaboratorMessages := []*v1pb.Collaborator{}
+	for _, collaborator := range collaborators {
+		collaboratorMessages = append(collaboratorMessages, convertCollaboratorFromStore(collaborator))
+	}
+	return collaboratorMessages, nil
+}
+
+func (s *APIV1Service) upsertCollaborator(ctx context.Context, resourceType store.CollaboratorResourceType, resourceID, creatorID, userID int32, role v1pb.CollaboratorRole) (*v1pb.Collaborator, error) {
+	collaboratorRole, ok := convertCollaboratorRoleToStore(role)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collaborator role %s", role)
+	}
+	if userID == creatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "the creator cannot be a collaborator")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	collaborator, err := s.Store.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		UserID:       user.ID,
+		Role:         collaboratorRole,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert collaborator, err: %v", err)
+	}
+	return convertCollaboratorFromStore(collaborator), nil
+}
+
+func convertCollaboratorFromStore(collaborator *store.Collaborator) *v1pb.Collaborator {
+	role := v1pb.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+	switch collaborator.Role {
+	case store.CollaboratorRoleViewer:
+		role = v1pb.CollaboratorRole_VIEWER
+	case store.CollaboratorRoleEditor:
+		role = v1pb.CollaboratorRole_EDITOR
+	}
+	return &v1pb.Collaborator{
+		UserId:     collaborator.UserID,
+		Role:       role,
+		CreateTime: timestamppb.New(time.Unix(collaborator.CreatedTs, 0)),
+	}
+}
+
+func convertCollaboratorRoleToStore(role v1pb.CollaboratorRole) (store.CollaboratorRole, bool) {
+	switch role {
+	case v1pb.CollaboratorRole_VIEWER:
+		return store.CollaboratorRoleViewer, true
+	case v1pb.CollaboratorRole_EDITOR:
+		return store.CollaboratorRoleEditor, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestCanShareResource(t *testing.T) {
+	admin := &store.User{ID: 1, Role: store.RoleAdmin}
+	creator := &store.User{ID: 2, Role: store.RoleUser}
+	editor := &store.User{ID: 3, Role: store.RoleUser}
+	require.True(t, canShareResource(admin, creator.ID))
+	require.True(t, canShareResource(creator, creator.ID))
+	require.False(t, canShareResource(editor, creator.ID))
+}
+
+func TestIsSharingChanged(t *testing.T) {
+	require.False(t, isSharingChanged(store.VisibilityGroup, []int32{2, 1}, storepb.Visibility_GROUP, []int32{1, 2}))
+	require.False(t, isSharingChanged(store.VisibilityPublic, nil, storepb.Visibility_PUBLIC, []int32{}))
+	require.True(t, isSharingChanged(store.VisibilityPrivate, nil, storepb.Visibility_PUBLIC, nil))
+	require.True(t, isSharingChanged(store.VisibilityGroup, []int32{1}, storepb.Visibility_GROUP, []int32{1, 2}))
+	require.True(t, isSharingChanged(store.VisibilityGroup, []int32{3}, storepb.Visibility_GROUP, []int32{1}))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -41,6 +42,52 @@ func (s *APIV1Service) ListCollections(ctx context.Context, _ *v1pb.ListCollecti
 	}
 	collections = append(collections, sharedCollections...)
 
+	groupCollections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		VisibilityList: []store.Visibility{
+			store.VisibilityGroup,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
+	}
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+		MemberID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups, err: %v", err)
+	}
+	for _, collection := range groupCollections {
+		if collection.CreatorId == user.ID || slices.ContainsFunc(groups, func(group *store.Group) bool {
+			return slices.Contains(collection.GroupIds, group.ID)
+		}) {
+			collections = append(collections, collection)
+		}
+	}
+
+	collaborators, err := s.Store.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		UserID:       &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collaborators, err: %v", err)
+	}
+	for _, collaborator := range collaborators {
+		if slices.ContainsFunc(collections, func(collection *storepb.Collection) bool {
+			return collection.Id == collaborator.ResourceID
+		}) {
+			continue
+		}
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			ID: &collaborator.ResourceID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+		}
+		if collection != nil {
+			collections = append(collections, collection)
+		}
+	}
+
 	convertedCollections := []*v1pb.Collection{}
 	for _, collection := range collections {
 		convertedCollections = append(convertedCollections, convertCollectionFromStore(collection))
@@ -67,7 +114,11 @@ func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetColle
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
 	}
-	if collection.Visibility == storepb.Visibility_PRIVATE && collection.CreatorId != user.ID {
+	visible, err := IsCollectionVisible(ctx, s.Store, collection, &user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	response := &v1pb.GetCollectionResponse{
@@ -87,15 +138,16 @@ func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
 
-	userID, ok := ctx.Value(userIDContextKey).(int32)
-	if ok {
-		if collection.Visibility == storepb.Visibility_PRIVATE && collection.CreatorId != userID {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-		}
-	} else {
-		if collection.Visibility != storepb.Visibility_PUBLIC {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-		}
+	var userID *int32
+	if v, ok := ctx.Value(userIDContextKey).(int32); ok {
+		userID = &v
+	}
+	visible, err := IsCollectionVisible(ctx, s.Store, collection, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	response := &v1pb.GetCollectionByNameResponse{
 		Collection: convertCollectionFromStore(collection),
@@ -110,7 +162,7 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 
 	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedAccounts) {
 		collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
-			VisibilityList: []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic},
+			VisibilityList: []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic, store.VisibilityGroup},
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
@@ -124,6 +176,13 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
 	}
+	groupIDs, err := s.getGroupIDs(ctx, request.Collection.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGroupVisibility(store.Visibility(request.Collection.Visibility.String()), groupIDs); err != nil {
+		return nil, err
+	}
 	collectionCreate := &storepb.Collection{
 		CreatorId:   user.ID,
 		Name:        request.Collection.Name,
@@ -131,6 +190,7 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		Description: request.Collection.Description,
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  storepb.Visibility(request.Collection.Visibility),
+		GroupIds:    groupIDs,
 	}
 	collection, err := s.Store.CreateCollection(ctx, collectionCreate)
 	if err != nil {
@@ -162,7 +222,11 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	canEdit, err := canEditResource(ctx, s.Store, user, store.CollaboratorResourceTypeCollection, collection.Id, collection.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection permission: %v", err)
+	}
+	if !canEdit {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -182,6 +246,27 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 		case "visibility":
 			visibility := store.Visibility(request.Collection.Visibility.String())
 			update.Visibility = &visibility
+		case "group_ids":
+			groupIDs, err := s.getGroupIDs(ctx, request.Collection.GroupIds)
+			if err != nil {
+				return nil, err
+			}
+			update.GroupIDs = groupIDs
+		}
+	}
+	if update.Visibility != nil || update.GroupIDs != nil {
+		visibility, groupIDs := store.Visibility(collection.Visibility.String()), collection.GroupIds
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		}
+		if update.GroupIDs != nil {
+			groupIDs = update.GroupIDs
+		}
+		if isSharingChanged(visibility, groupIDs, collection.Visibility, collection.GroupIds) && !canShareResource(user, collection.CreatorId) {
+			return nil, status.Errorf(codes.PermissionDenied, "only the creator or an admin can change the visibility and the groups of the collection")
+		}
+		if err := validateGroupVisibility(visibility, groupIDs); err != nil {
+			return nil, err
 		}
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
@@ -209,12 +294,18 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	canEdit, err := canEditResource(ctx, s.Store, user, store.CollaboratorResourceTypeCollection, collection.Id, collection.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection permission: %v", err)
+	}
+	if !canEdit {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
-	err = s.Store.DeleteCollection(ctx, &store.DeleteCollection{
-		ID: collection.Id,
+	deletedTs := time.Now().Unix()
+	_, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		DeletedTs: &deletedTs,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete collection, err: %v", err)
@@ -223,8 +314,207 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	return response, nil
 }
 
+func (s *APIV1Service) RestoreCollection(ctx context.Context, request *v1pb.RestoreCollectionRequest) (*v1pb.RestoreCollectionResponse, error) {
+	collection, err := s.getDeletedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the shortcuts which were purged while the collection was in the trash.
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		IDList:         collection.ShortcutIds,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	existingShortcutIDs := map[int32]bool{}
+	for _, shortcut := range shortcuts {
+		existingShortcutIDs[shortcut.Id] = true
+	}
+	shortcutIDs := []int32{}
+	for _, shortcutID := range collection.ShortcutIds {
+		if existingShortcutIDs[shortcutID] {
+			shortcutIDs = append(shortcutIDs, shortcutID)
+		}
+	}
+	deletedTs := int64(0)
+	collection, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:          collection.Id,
+		ShortcutIDs: shortcutIDs,
+		DeletedTs:   &deletedTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore collection, err: %v", err)
+	}
+	return &v1pb.RestoreCollectionResponse{
+		Collection: convertCollectionFromStore(collection),
+	}, nil
+}
+
+func (s *APIV1Service) PurgeCollection(ctx context.Context, request *v1pb.PurgeCollectionRequest) (*v1pb.PurgeCollectionResponse, error) {
+	collection, err := s.getDeletedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteCollection(ctx, &store.DeleteCollection{
+		ID: collection.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge collection, err: %v", err)
+	}
+	return &v1pb.PurgeCollectionResponse{}, nil
+}
+
+// getDeletedCollection returns the collection in the trash which the current user can restore or purge.
+func (s *APIV1Service) getDeletedCollection(ctx context.Context, id int32) (*storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID:          &id,
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found in the trash")
+	}
+	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return collection, nil
+}
+
+func (s *APIV1Service) ListCollectionCollaborators(ctx context.Context, request *v1pb.ListCollectionCollaboratorsRequest) (*v1pb.ListCollectionCollaboratorsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	visible, err := IsCollectionVisible(ctx, s.Store, collection, &user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	collaborators, err := s.listCollaborators(ctx, store.CollaboratorResourceTypeCollection, collection.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListCollectionCollaboratorsResponse{
+		Collaborators: collaborators,
+	}, nil
+}
+
+func (s *APIV1Service) UpsertCollectionCollaborator(ctx context.Context, request *v1pb.UpsertCollectionCollaboratorRequest) (*v1pb.UpsertCollectionCollaboratorResponse, error) {
+	collection, err := s.getOwnedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	collaborator, err := s.upsertCollaborator(ctx, store.CollaboratorResourceTypeCollection, collection.Id, collection.CreatorId, request.UserId, request.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.UpsertCollectionCollaboratorResponse{
+		Collaborator: collaborator,
+	}, nil
+}
+
+func (s *APIV1Service) DeleteCollectionCollaborator(ctx context.Context, request *v1pb.DeleteCollectionCollaboratorRequest) (*v1pb.DeleteCollectionCollaboratorResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	// Collaborators can leave the collection by themselves.
+	collectionID := request.Id
+	if user.ID != request.UserId {
+		collection, err := s.getOwnedCollection(ctx, request.Id)
+		if err != nil {
+			return nil, err
+		}
+		collectionID = collection.Id
+	}
+	if err := s.Store.DeleteCollaborator(ctx, &store.DeleteCollaborator{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		ResourceID:   collectionID,
+		UserID:       request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collaborator, err: %v", err)
+	}
+	return &v1pb.DeleteCollectionCollaboratorResponse{}, nil
+}
+
+func (s *APIV1Service) TransferCollectionOwnership(ctx context.Context, request *v1pb.TransferCollectionOwnershipRequest) (*v1pb.TransferCollectionOwnershipResponse, error) {
+	collection, err := s.getOwnedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTransferTarget(ctx, collection.CreatorId, request.NewOwnerId); err != nil {
+		return nil, err
+	}
+
+	// The new owner does not need to be a collaborator anymore.
+	if err := s.Store.TransferResourceOwnership(ctx, &store.TransferResourceOwnership{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		ResourceID:   collection.Id,
+		ToUserID:     request.NewOwnerId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer collection, err: %v", err)
+	}
+	collection, err = s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &collection.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+
+	return &v1pb.TransferCollectionOwnershipResponse{
+		Collection: convertCollectionFromStore(collection),
+	}, nil
+}
+
+// getOwnedCollection returns the collection which the current user can manage the collaborators and the ownership of,
+// i.e. the user is its creator or an admin.
+func (s *APIV1Service) getOwnedCollection(ctx context.Context, id int32) (*storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return collection, nil
+}
+
+// IsCollectionVisible returns true if the collection can be seen by the user with the given id.
+// A nil userID stands for an anonymous visitor, who can only see public collections.
+func IsCollectionVisible(ctx context.Context, s *store.Store, collection *storepb.Collection, userID *int32) (bool, error) {
+	return isResourceVisible(ctx, s, store.CollaboratorResourceTypeCollection, collection.Id, collection.Visibility, collection.CreatorId, collection.GroupIds, userID)
+}
+
 func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection {
-	return &v1pb.Collection{
+	composedCollection := &v1pb.Collection{
 		Id:          collection.Id,
 		CreatorId:   collection.CreatorId,
 		CreatedTime: timestamppb.New(time.Unix(collection.CreatedTs, 0)),
@@ -234,5 +524,10 @@ func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection
 		Description: collection.Description,
 		ShortcutIds: collection.ShortcutIds,
 		Visibility:  v1pb.Visibility(collection.Visibility),
+		GroupIds:    collection.GroupIds,
+	}
+	if collection.DeletedTs != 0 {
+		composedCollection.DeleteTime = timestamppb.New(time.Unix(collection.DeletedTs, 0))
 	}
+	return composedCollection
 }
@@ -2,6 +2,10 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	"github.com/yourselfhosted/slash/store"
@@ -18,6 +22,15 @@ func convertRowStatusFromStore(rowStatus store.RowStatus) v1pb.RowStatus {
 	}
 }
 
+func convertRowStatusToStore(rowStatus v1pb.RowStatus) store.RowStatus {
+	switch rowStatus {
+	case v1pb.RowStatus_ARCHIVED:
+		return store.Archived
+	default:
+		return store.Normal
+	}
+}
+
 func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
 	userID, ok := ctx.Value(userIDContextKey).(int32)
 	if !ok {
@@ -31,3 +44,31 @@ func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
 	}
 	return user, nil
 }
+
+func getPageToken(limit int, offset int, filter, orderBy string) (string, error) {
+	return marshalPageToken(&v1pb.PageToken{
+		Limit:   int32(limit),
+		Offset:  int32(offset),
+		Filter:  filter,
+		OrderBy: orderBy,
+	})
+}
+
+func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
+	b, err := proto.Marshal(pageToken)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal page token")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func unmarshalPageToken(s string, pageToken *v1pb.PageToken) error {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode page token")
+	}
+	if err := proto.Unmarshal(b, pageToken); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal page token")
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+
+	groupMessages := []*v1pb.Group{}
+	for _, group := range groups {
+		groupMessage, err := s.convertGroupFromStore(ctx, group)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+		}
+		groupMessages = append(groupMessages, groupMessage)
+	}
+	response := &v1pb.ListGroupsResponse{
+		Groups: groupMessages,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.GetGroupResponse, error) {
+	group, err := s.findGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	response := &v1pb.GetGroupResponse{
+		Group: groupMessage,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.CreateGroupResponse, error) {
+	if request.Group == nil || request.Group.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if err := s.checkGroupNameAvailable(ctx, 0, request.Group.Name); err != nil {
+		return nil, err
+	}
+
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		Name:        request.Group.Name,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	response := &v1pb.CreateGroupResponse{
+		Group: groupMessage,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.UpdateGroupResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	group, err := s.findGroup(ctx, request.Group.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateGroup{
+		ID: group.ID,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "name":
+			if request.Group.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if err := s.checkGroupNameAvailable(ctx, group.ID, request.Group.Name); err != nil {
+				return nil, err
+			}
+			update.Name = &request.Group.Name
+		case "description":
+			update.Description = &request.Group.Description
+		}
+	}
+	group, err = s.Store.UpdateGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	response := &v1pb.UpdateGroupResponse{
+		Group: groupMessage,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*v1pb.DeleteGroupResponse, error) {
+	group, err := s.findGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	response := &v1pb.DeleteGroupResponse{}
+	return response, nil
+}
+
+func (s *APIV1Service) ListGroupMembers(ctx context.Context, request *v1pb.ListGroupMembersRequest) (*v1pb.ListGroupMembersResponse, error) {
+	group, err := s.findGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+
+	userMessages := []*v1pb.User{}
+	for _, member := range members {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &member.UserID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+		}
+		if user == nil {
+			continue
+		}
+		userMessages = append(userMessages, convertUserFromStore(user))
+	}
+	response := &v1pb.ListGroupMembersResponse{
+		Members: userMessages,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) AddGroupMember(ctx context.Context, request *v1pb.AddGroupMemberRequest) (*v1pb.AddGroupMemberResponse, error) {
+	group, err := s.findGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	response := &v1pb.AddGroupMemberResponse{}
+	return response, nil
+}
+
+func (s *APIV1Service) RemoveGroupMember(ctx context.Context, request *v1pb.RemoveGroupMemberRequest) (*v1pb.RemoveGroupMemberResponse, error) {
+	group, err := s.findGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteGroupMember(ctx, &store.DeleteGroupMember{
+		GroupID: group.ID,
+		UserID:  request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+	}
+	response := &v1pb.RemoveGroupMemberResponse{}
+	return response, nil
+}
+
+func (s *APIV1Service) findGroup(ctx context.Context, id int32) (*store.Group, error) {
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return group, nil
+}
+
+// checkGroupNameAvailable returns an AlreadyExists error if another group than the one with the given id has the name.
+func (s *APIV1Service) checkGroupNameAvailable(ctx context.Context, groupID int32, name string) error {
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		Name: &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find group: %v", err)
+	}
+	if group != nil && group.ID != groupID {
+		return status.Errorf(codes.AlreadyExists, "group name %q is already used", name)
+	}
+	return nil
+}
+
+// getGroupIDs returns the given group ids without duplicates, after checking that all the groups exist.
+func (s *APIV1Service) getGroupIDs(ctx context.Context, groupIDs []int32) ([]int32, error) {
+	list := []int32{}
+	for _, groupID := range groupIDs {
+		if !slices.Contains(list, groupID) {
+			list = append(list, groupID)
+		}
+	}
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+		IDList: list,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	if len(groups) != len(list) {
+		return nil, status.Errorf(codes.InvalidArgument, "group not found")
+	}
+	return list, nil
+}
+
+// validateGroupVisibility checks that the resources shared with groups have at least one group.
+func validateGroupVisibility(visibility store.Visibility, groupIDs []int32) error {
+	if visibility == store.VisibilityGroup && len(groupIDs) == 0 {
+		return status.Errorf(codes.InvalidArgument, "group_ids are required for the group visibility")
+	}
+	return nil
+}
+
+func (s *APIV1Service) convertGroupFromStore(ctx context.Context, group *store.Group) (*v1pb.Group, error) {
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.Group{
+		Id:          group.ID,
+		CreatedTime: timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+		Name:        group.Name,
+		Description: group.Description,
+		MemberCount: int32(len(members)),
+	}, nil
+}
@@ -22,6 +22,12 @@ func (in *LoggerInterceptor) LoggerInterceptor(ctx context.Context, request any,
 	return resp, err
 }
 
+func (in *LoggerInterceptor) LoggerStreamInterceptor(srv any, serverStream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, serverStream)
+	in.loggerInterceptorDo(serverStream.Context(), serverInfo.FullMethod, err)
+	return err
+}
+
 func (*LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod string, err error) {
 	st := status.Convert(err)
 	var logLevel slog.Level
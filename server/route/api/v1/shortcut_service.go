@@ -3,10 +3,11 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/mssola/useragent"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
@@ -14,34 +15,87 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/protoadapt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/filter"
+	"github.com/yourselfhosted/slash/internal/linktemplate"
+	"github.com/yourselfhosted/slash/internal/suggestion"
+	"github.com/yourselfhosted/slash/internal/util"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/server/service/importer"
 	"github.com/yourselfhosted/slash/store"
 )
 
-func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+const (
+	// maxShortcutPageSize is the maximum page size of ListShortcuts.
+	maxShortcutPageSize = 1000
+	// defaultSearchLimit and maxSearchLimit are the default and maximum number of results of SearchShortcuts.
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// defaultSuggestionLimit and maxSuggestionLimit are the default and maximum number of shortcut suggestions.
+	defaultSuggestionLimit = 5
+	maxSuggestionLimit     = 20
+	// maxSuggestionNameLength is the maximum length of the names to suggest similar names for.
+	maxSuggestionNameLength = 256
+	// maxSuggestionCandidates is the maximum number of shortcuts compared with the name to suggest for.
+	maxSuggestionCandidates = 1000
+)
+
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
 	}
-	find := &store.FindShortcut{}
-	find.VisibilityList = []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic}
-	visibleShortcutList, err := s.Store.ListShortcuts(ctx, find)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch visible shortcut list, err: %v", err)
+	find := &store.FindShortcut{
+		ViewerID: &user.ID,
+	}
+	if err := applyShortcutFilter(find, request.Filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if err := applyShortcutOrderBy(find, request.OrderBy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by: %v", err)
 	}
 
-	find.VisibilityList = []store.Visibility{store.VisibilityPrivate}
-	find.CreatorID = &user.ID
+	limit, offset := int(request.PageSize), 0
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		// The offset of the token is only meaningful for the same filter and order.
+		if pageToken.Filter != request.Filter || pageToken.OrderBy != request.OrderBy {
+			return nil, status.Errorf(codes.InvalidArgument, "page token does not match the filter or the order by of the request")
+		}
+		if limit <= 0 {
+			limit = int(pageToken.Limit)
+		}
+		offset = int(pageToken.Offset)
+	}
+	if limit > maxShortcutPageSize {
+		limit = maxShortcutPageSize
+	}
+	if limit > 0 {
+		// Fetch one more shortcut to check if there is a next page.
+		limitPlusOne := limit + 1
+		find.Limit = &limitPlusOne
+		find.Offset = &offset
+	}
 	shortcutList, err := s.Store.ListShortcuts(ctx, find)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch private shortcut list, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch shortcut list, err: %v", err)
 	}
 
-	shortcutList = append(shortcutList, visibleShortcutList...)
+	nextPageToken := ""
+	if limit > 0 && len(shortcutList) > limit {
+		shortcutList = shortcutList[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit, request.Filter, request.OrderBy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, err: %v", err)
+		}
+	}
 	shortcuts := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutList {
 		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
@@ -52,11 +106,50 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsR
 	}
 
 	response := &v1pb.ListShortcutsResponse{
-		Shortcuts: shortcuts,
+		Shortcuts:     shortcuts,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
 
+func (s *APIV1Service) SearchShortcuts(ctx context.Context, request *v1pb.SearchShortcutsRequest) (*v1pb.SearchShortcutsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	searchResults, err := s.Store.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms:    store.GetSearchTerms(request.Query),
+		ViewerID: &user.ID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search shortcuts, err: %v", err)
+	}
+
+	results := []*v1pb.SearchShortcutsResponse_Result{}
+	for _, searchResult := range searchResults {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, searchResult.Shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		results = append(results, &v1pb.SearchShortcutsResponse_Result{
+			Shortcut: composedShortcut,
+			Rank:     searchResult.Rank,
+			Snippet:  searchResult.Snippet,
+		})
+	}
+	return &v1pb.SearchShortcutsResponse{
+		Results: results,
+	}, nil
+}
+
 func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcutRequest) (*v1pb.GetShortcutResponse, error) {
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 		ID: &request.Id,
@@ -68,15 +161,16 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 
-	userID, ok := ctx.Value(userIDContextKey).(int32)
-	if ok {
-		if shortcut.Visibility == storepb.Visibility_PRIVATE && shortcut.CreatorId != userID {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-		}
-	} else {
-		if shortcut.Visibility != storepb.Visibility_PUBLIC {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-		}
+	var userID *int32
+	if v, ok := ctx.Value(userIDContextKey).(int32); ok {
+		userID = &v
+	}
+	visible, err := IsShortcutVisible(ctx, s.Store, shortcut, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
@@ -96,54 +190,172 @@ func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetS
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
-	if shortcut == nil {
-		return nil, status.Errorf(codes.NotFound, "shortcut not found")
-	}
 
-	userID, ok := ctx.Value(userIDContextKey).(int32)
-	if ok {
-		if shortcut.Visibility == storepb.Visibility_PRIVATE && shortcut.CreatorId != userID {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	var userID *int32
+	if v, ok := ctx.Value(userIDContextKey).(int32); ok {
+		userID = &v
+	}
+	if shortcut == nil {
+		suggestions, err := s.getShortcutSuggestions(ctx, request.Name, userID, defaultSuggestionLimit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list shortcut suggestions: %v", err)
 		}
-	} else {
-		if shortcut.Visibility != storepb.Visibility_PUBLIC {
-			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		details := []protoadapt.MessageV1{}
+		for _, item := range suggestions {
+			details = append(details, item)
 		}
+		st, err := status.New(codes.NotFound, "shortcut not found").WithDetails(details...)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "shortcut not found")
+		}
+		return nil, st.Err()
+	}
+	visible, err := IsShortcutVisible(ctx, s.Store, shortcut, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	// Resolve to the fallback link if the shortcut is not active.
+	active := IsShortcutActive(shortcut, time.Now())
+	if !active && shortcut.FallbackLink == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "shortcut is not active")
 	}
 
 	// Create shortcut view activity.
-	if err := s.createShortcutViewActivity(ctx, shortcut); err != nil {
-		fmt.Printf("failed to create activity, err: %v", err)
+	if active {
+		if err := s.createShortcutViewActivity(ctx, shortcut); err != nil {
+			fmt.Printf("failed to create activity, err: %v", err)
+		}
 	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
 	}
+	if !active {
+		composedShortcut.Link = shortcut.FallbackLink
+	}
 	response := &v1pb.GetShortcutByNameResponse{
 		Shortcut: composedShortcut,
 	}
 	return response, nil
 }
 
+func (s *APIV1Service) ListShortcutSuggestions(ctx context.Context, request *v1pb.ListShortcutSuggestionsRequest) (*v1pb.ListShortcutSuggestionsResponse, error) {
+	var userID *int32
+	if v, ok := ctx.Value(userIDContextKey).(int32); ok {
+		userID = &v
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestionLimit
+	}
+	if limit > maxSuggestionLimit {
+		limit = maxSuggestionLimit
+	}
+	suggestions, err := s.getShortcutSuggestions(ctx, request.Name, userID, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut suggestions: %v", err)
+	}
+	return &v1pb.ListShortcutSuggestionsResponse{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// getShortcutSuggestions returns the shortcuts visible to the user with a name or an alias similar to the given name.
+func (s *APIV1Service) getShortcutSuggestions(ctx context.Context, name string, userID *int32, limit int) ([]*v1pb.ShortcutSuggestion, error) {
+	suggestions := []*v1pb.ShortcutSuggestion{}
+	if name == "" || utf8.RuneCountInString(name) > maxSuggestionNameLength {
+		return suggestions, nil
+	}
+	// Only the shortcuts which can be similar to the name are compared with it.
+	candidateLimit := maxSuggestionCandidates
+	find := &store.FindShortcut{
+		SimilarName: &store.SimilarName{
+			Name:        name,
+			MaxDistance: suggestion.MaxDistance(name),
+		},
+		Limit: &candidateLimit,
+	}
+	if userID == nil {
+		find.VisibilityList = []store.Visibility{store.VisibilityPublic}
+	} else {
+		find.ViewerID = userID
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	shortcutMap := map[string]*storepb.Shortcut{}
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		for _, shortcutName := range append([]string{shortcut.Name}, shortcut.Aliases...) {
+			shortcutMap[shortcutName] = shortcut
+			names = append(names, shortcutName)
+		}
+	}
+
+	suggestedShortcutIDs := map[int32]bool{}
+	for _, match := range suggestion.Rank(name, names) {
+		if len(suggestions) >= limit {
+			break
+		}
+		shortcut := shortcutMap[match.Name]
+		// Only suggest the best matched name of each shortcut.
+		if suggestedShortcutIDs[shortcut.Id] {
+			continue
+		}
+		suggestedShortcutIDs[shortcut.Id] = true
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, &v1pb.ShortcutSuggestion{
+			Name:     match.Name,
+			Shortcut: composedShortcut,
+			Score:    match.Score,
+		})
+	}
+	return suggestions, nil
+}
+
 func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateShortcutRequest) (*v1pb.CreateShortcutResponse, error) {
 	if request.Shortcut.Name == "" || request.Shortcut.Link == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "name and link are required")
 	}
+	if err := linktemplate.Validate(request.Shortcut.Link); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+	}
+	if err := s.checkShortcutNamesAvailable(ctx, 0, append([]string{request.Shortcut.Name}, request.Shortcut.Aliases...)); err != nil {
+		return nil, err
+	}
 
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
 	}
 	shortcutCreate := &storepb.Shortcut{
-		CreatorId:   user.ID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  storepb.Visibility(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
+		CreatorId:    user.ID,
+		Name:         request.Shortcut.Name,
+		Link:         request.Shortcut.Link,
+		Title:        request.Shortcut.Title,
+		Tags:         request.Shortcut.Tags,
+		Description:  request.Shortcut.Description,
+		Visibility:   storepb.Visibility(request.Shortcut.Visibility),
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		Aliases:      request.Shortcut.Aliases,
+		FallbackLink: request.Shortcut.FallbackLink,
+	}
+	if request.Shortcut.StartTime != nil {
+		shortcutCreate.StartTs = request.Shortcut.StartTime.AsTime().Unix()
+	}
+	if request.Shortcut.ExpireTime != nil {
+		shortcutCreate.ExpireTs = request.Shortcut.ExpireTime.AsTime().Unix()
+	}
+	if err := validateShortcutWindow(shortcutCreate.StartTs, shortcutCreate.ExpireTs); err != nil {
+		return nil, err
 	}
 	if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
 		getWorkspaceSettingResponse, err := s.GetWorkspaceSetting(ctx, nil)
@@ -157,6 +369,14 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		}
 		shortcutCreate.Visibility = storepb.Visibility(visibility)
 	}
+	groupIDs, err := s.getGroupIDs(ctx, request.Shortcut.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGroupVisibility(store.Visibility(shortcutCreate.Visibility.String()), groupIDs); err != nil {
+		return nil, err
+	}
+	shortcutCreate.GroupIds = groupIDs
 	if request.Shortcut.OgMetadata != nil {
 		shortcutCreate.OgMetadata = &storepb.OpenGraphMetadata{
 			Title:       request.Shortcut.OgMetadata.Title,
@@ -201,7 +421,11 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	canEdit, err := canEditResource(ctx, s.Store, user, store.CollaboratorResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !canEdit {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -213,6 +437,9 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		case "name":
 			update.Name = &request.Shortcut.Name
 		case "link":
+			if err := linktemplate.Validate(request.Shortcut.Link); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+			}
 			update.Link = &request.Shortcut.Link
 		case "title":
 			update.Title = &request.Shortcut.Title
@@ -232,7 +459,78 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 					Image:       request.Shortcut.OgMetadata.Image,
 				}
 			}
+		case "aliases":
+			update.Aliases = &request.Shortcut.Aliases
+		case "start_time":
+			startTs := int64(0)
+			if request.Shortcut.StartTime != nil {
+				startTs = request.Shortcut.StartTime.AsTime().Unix()
+			}
+			update.StartTs = &startTs
+		case "expire_time":
+			expireTs := int64(0)
+			if request.Shortcut.ExpireTime != nil {
+				expireTs = request.Shortcut.ExpireTime.AsTime().Unix()
+			}
+			update.ExpireTs = &expireTs
+		case "fallback_link":
+			update.FallbackLink = &request.Shortcut.FallbackLink
+		case "group_ids":
+			groupIDs, err := s.getGroupIDs(ctx, request.Shortcut.GroupIds)
+			if err != nil {
+				return nil, err
+			}
+			update.GroupIDs = &groupIDs
+		}
+	}
+	if update.Visibility != nil || update.GroupIDs != nil {
+		visibility, groupIDs := store.Visibility(shortcut.Visibility.String()), shortcut.GroupIds
+		if update.Visibility != nil {
+			visibility = *update.Visibility
 		}
+		if update.GroupIDs != nil {
+			groupIDs = *update.GroupIDs
+		}
+		if isSharingChanged(visibility, groupIDs, shortcut.Visibility, shortcut.GroupIds) && !canShareResource(user, shortcut.CreatorId) {
+			return nil, status.Errorf(codes.PermissionDenied, "only the creator or an admin can change the visibility and the groups of the shortcut")
+		}
+		if err := validateGroupVisibility(visibility, groupIDs); err != nil {
+			return nil, err
+		}
+	}
+	if update.StartTs != nil || update.ExpireTs != nil {
+		startTs, expireTs := shortcut.StartTs, shortcut.ExpireTs
+		if update.StartTs != nil {
+			startTs = *update.StartTs
+		}
+		if update.ExpireTs != nil {
+			expireTs = *update.ExpireTs
+		}
+		if err := validateShortcutWindow(startTs, expireTs); err != nil {
+			return nil, err
+		}
+		if isShortcutUnexpired(shortcut, expireTs) {
+			rowStatus := store.Normal
+			update.RowStatus = &rowStatus
+		}
+	}
+	if update.Name != nil || update.Aliases != nil {
+		names := []string{shortcut.Name}
+		if update.Name != nil {
+			names[0] = *update.Name
+		}
+		if update.Aliases != nil {
+			names = append(names, *update.Aliases...)
+		} else {
+			names = append(names, shortcut.Aliases...)
+		}
+		if err := s.checkShortcutNamesAvailable(ctx, shortcut.Id, names); err != nil {
+			return nil, err
+		}
+	}
+	update.Revision = &store.UpdateShortcutRevision{
+		EditorID: user.ID,
+		Previous: shortcut,
 	}
 	shortcut, err = s.Store.UpdateShortcut(ctx, update)
 	if err != nil {
@@ -263,12 +561,18 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	canEdit, err := canEditResource(ctx, s.Store, user, store.CollaboratorResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !canEdit {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
-	err = s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
-		ID: shortcut.Id,
+	deletedTs := time.Now().Unix()
+	_, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete shortcut, err: %v", err)
@@ -277,7 +581,117 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return response, nil
 }
 
-func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+func (s *APIV1Service) ListTrash(ctx context.Context, _ *v1pb.ListTrashRequest) (*v1pb.ListTrashResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	// Admins see the whole trash, other users only the items they created.
+	var creatorID *int32
+	if user.Role != store.RoleAdmin {
+		creatorID = &user.ID
+	}
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID:   creatorID,
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	sort.SliceStable(shortcuts, func(i, j int) bool {
+		return shortcuts[i].DeletedTs > shortcuts[j].DeletedTs
+	})
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		CreatorID:   creatorID,
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections, err: %v", err)
+	}
+	sort.SliceStable(collections, func(i, j int) bool {
+		return collections[i].DeletedTs > collections[j].DeletedTs
+	})
+
+	response := &v1pb.ListTrashResponse{
+		Shortcuts:   []*v1pb.Shortcut{},
+		Collections: []*v1pb.Collection{},
+	}
+	for _, shortcut := range shortcuts {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.Shortcuts = append(response.Shortcuts, composedShortcut)
+	}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, convertCollectionFromStore(collection))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreShortcut(ctx context.Context, request *v1pb.RestoreShortcutRequest) (*v1pb.RestoreShortcutResponse, error) {
+	shortcut, err := s.getDeletedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	deletedTs := int64(0)
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore shortcut, err: %v", err)
+	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return &v1pb.RestoreShortcutResponse{
+		Shortcut: composedShortcut,
+	}, nil
+}
+
+func (s *APIV1Service) PurgeShortcut(ctx context.Context, request *v1pb.PurgeShortcutRequest) (*v1pb.PurgeShortcutResponse, error) {
+	shortcut, err := s.getDeletedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge shortcut, err: %v", err)
+	}
+	return &v1pb.PurgeShortcutResponse{}, nil
+}
+
+// getDeletedShortcut returns the shortcut in the trash which the current user can restore or purge.
+func (s *APIV1Service) getDeletedShortcut(ctx context.Context, id int32) (*storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:          &id,
+		OnlyDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found in the trash")
+	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return shortcut, nil
+}
+
+func (s *APIV1Service) ListShortcutRevisions(ctx context.Context, request *v1pb.ListShortcutRevisionsRequest) (*v1pb.ListShortcutRevisionsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 		ID: &request.Id,
 	})
@@ -287,42 +701,426 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
+	visible, err := IsShortcutVisible(ctx, s.Store, shortcut, &user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
 
-	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		PayloadShortcutID: &shortcut.Id,
+	revisions, err := s.Store.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: shortcut.Id,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut revisions, err: %v", err)
+	}
+	response := &v1pb.ListShortcutRevisionsResponse{
+		Revisions: []*v1pb.ShortcutRevision{},
+	}
+	for _, revision := range revisions {
+		composedRevision, err := s.convertShortcutRevisionFromStore(ctx, revision)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut revision, err: %v", err)
+		}
+		response.Revisions = append(response.Revisions, composedRevision)
 	}
+	return response, nil
+}
 
-	referenceMap := make(map[string]int32)
-	deviceMap := make(map[string]int32)
-	browserMap := make(map[string]int32)
-	for _, activity := range activities {
-		payload := &storepb.ActivityShorcutViewPayload{}
-		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
+func (s *APIV1Service) RevertShortcut(ctx context.Context, request *v1pb.RevertShortcutRequest) (*v1pb.RevertShortcutResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	canEdit, err := canEditResource(ctx, s.Store, user, store.CollaboratorResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !canEdit {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	revisions, err := s.Store.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut revisions, err: %v", err)
+	}
+	var revision *store.ShortcutRevision
+	for _, item := range revisions {
+		if item.ID == request.RevisionId {
+			revision = item
 		}
+	}
+	if revision == nil || revision.Previous == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut revision not found")
+	}
+
+	// Restore the content of the shortcut, while its status and creator are kept.
+	previous := revision.Previous
+	tag := strings.Join(previous.Tags, " ")
+	visibility := store.Visibility(previous.Visibility.String())
+	update := &store.UpdateShortcut{
+		ID:                shortcut.Id,
+		Name:              &previous.Name,
+		Link:              &previous.Link,
+		Title:             &previous.Title,
+		Description:       &previous.Description,
+		Visibility:        &visibility,
+		Tag:               &tag,
+		OpenGraphMetadata: previous.OgMetadata,
+		StartTs:           &previous.StartTs,
+		ExpireTs:          &previous.ExpireTs,
+		FallbackLink:      &previous.FallbackLink,
+		Aliases:           &previous.Aliases,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: user.ID,
+			Previous: shortcut,
+		},
+	}
+	if update.OpenGraphMetadata == nil {
+		update.OpenGraphMetadata = &storepb.OpenGraphMetadata{}
+	}
+	if isShortcutUnexpired(shortcut, previous.ExpireTs) {
+		rowStatus := store.Normal
+		update.RowStatus = &rowStatus
+	}
+	// Skip the groups which were deleted since the revision.
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+		IDList: append([]int32{}, previous.GroupIds...),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups, err: %v", err)
+	}
+	groupIDs := []int32{}
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	update.GroupIDs = &groupIDs
+	if isSharingChanged(visibility, groupIDs, shortcut.Visibility, shortcut.GroupIds) && !canShareResource(user, shortcut.CreatorId) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the creator or an admin can revert the visibility and the groups of the shortcut")
+	}
+	if err := s.checkShortcutNamesAvailable(ctx, shortcut.Id, append([]string{previous.Name}, previous.Aliases...)); err != nil {
+		return nil, err
+	}
+	reverted, err := s.Store.UpdateShortcut(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revert shortcut, err: %v", err)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, reverted)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return &v1pb.RevertShortcutResponse{
+		Shortcut: composedShortcut,
+	}, nil
+}
 
-		if _, ok := referenceMap[payload.Referer]; !ok {
-			referenceMap[payload.Referer] = 0
+func (s *APIV1Service) ListShortcutCollaborators(ctx context.Context, request *v1pb.ListShortcutCollaboratorsRequest) (*v1pb.ListShortcutCollaboratorsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	visible, err := IsShortcutVisible(ctx, s.Store, shortcut, &user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	collaborators, err := s.listCollaborators(ctx, store.CollaboratorResourceTypeShortcut, shortcut.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListShortcutCollaboratorsResponse{
+		Collaborators: collaborators,
+	}, nil
+}
+
+func (s *APIV1Service) UpsertShortcutCollaborator(ctx context.Context, request *v1pb.UpsertShortcutCollaboratorRequest) (*v1pb.UpsertShortcutCollaboratorResponse, error) {
+	shortcut, err := s.getOwnedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	collaborator, err := s.upsertCollaborator(ctx, store.CollaboratorResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, request.UserId, request.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.UpsertShortcutCollaboratorResponse{
+		Collaborator: collaborator,
+	}, nil
+}
+
+func (s *APIV1Service) DeleteShortcutCollaborator(ctx context.Context, request *v1pb.DeleteShortcutCollaboratorRequest) (*v1pb.DeleteShortcutCollaboratorResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	// Collaborators can leave the shortcut by themselves.
+	shortcutID := request.Id
+	if user.ID != request.UserId {
+		shortcut, err := s.getOwnedShortcut(ctx, request.Id)
+		if err != nil {
+			return nil, err
 		}
-		referenceMap[payload.Referer]++
+		shortcutID = shortcut.Id
+	}
+	if err := s.Store.DeleteCollaborator(ctx, &store.DeleteCollaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcutID,
+		UserID:       request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collaborator, err: %v", err)
+	}
+	return &v1pb.DeleteShortcutCollaboratorResponse{}, nil
+}
+
+func (s *APIV1Service) TransferShortcutOwnership(ctx context.Context, request *v1pb.TransferShortcutOwnershipRequest) (*v1pb.TransferShortcutOwnershipResponse, error) {
+	shortcut, err := s.getOwnedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTransferTarget(ctx, shortcut.CreatorId, request.NewOwnerId); err != nil {
+		return nil, err
+	}
+
+	// The new owner does not need to be a collaborator anymore.
+	if err := s.Store.TransferResourceOwnership(ctx, &store.TransferResourceOwnership{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		ToUserID:     request.NewOwnerId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer shortcut, err: %v", err)
+	}
+	shortcut, err = s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return &v1pb.TransferShortcutOwnershipResponse{
+		Shortcut: composedShortcut,
+	}, nil
+}
 
-		ua := useragent.New(payload.UserAgent)
-		deviceName := ua.OSInfo().Name
-		browserName, _ := ua.Browser()
+// getOwnedShortcut returns the shortcut which the current user can manage the collaborators and the ownership of,
+// i.e. the user is its creator or an admin.
+func (s *APIV1Service) getOwnedShortcut(ctx context.Context, id int32) (*storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return shortcut, nil
+}
 
-		if _, ok := deviceMap[deviceName]; !ok {
-			deviceMap[deviceName] = 0
+func (s *APIV1Service) ImportShortcuts(ctx context.Context, request *v1pb.ImportShortcutsRequest) (*v1pb.ImportShortcutsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
+	}
+	format := importer.DetectFormat(request.Content)
+	switch request.Format {
+	case v1pb.ImportShortcutsRequest_CSV:
+		format = importer.FormatCSV
+	case v1pb.ImportShortcutsRequest_JSON:
+		format = importer.FormatJSON
+	case v1pb.ImportShortcutsRequest_HTML:
+		format = importer.FormatHTML
+	}
+	conflict := importer.ConflictSkip
+	switch request.ConflictPolicy {
+	case v1pb.ImportShortcutsRequest_OVERWRITE:
+		conflict = importer.ConflictOverwrite
+	case v1pb.ImportShortcutsRequest_RENAME:
+		conflict = importer.ConflictRename
+	}
+
+	shortcuts, err := importer.Parse(format, request.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse content: %v", err)
+	}
+	results, err := s.ImportService.Import(ctx, shortcuts, &importer.Options{
+		CreatorID: user.ID,
+		Conflict:  conflict,
+		DryRun:    request.DryRun,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import shortcuts: %v", err)
+	}
+
+	response := &v1pb.ImportShortcutsResponse{
+		Results: []*v1pb.ImportShortcutsResponse_Result{},
+	}
+	for _, result := range results {
+		action := v1pb.ImportShortcutsResponse_Result_ACTION_UNSPECIFIED
+		switch result.Action {
+		case importer.ActionCreated:
+			action = v1pb.ImportShortcutsResponse_Result_CREATED
+			response.CreatedCount++
+		case importer.ActionUpdated:
+			action = v1pb.ImportShortcutsResponse_Result_UPDATED
+			response.UpdatedCount++
+		case importer.ActionSkipped:
+			action = v1pb.ImportShortcutsResponse_Result_SKIPPED
+			response.SkippedCount++
+		case importer.ActionFailed:
+			action = v1pb.ImportShortcutsResponse_Result_FAILED
+			response.FailedCount++
 		}
-		deviceMap[deviceName]++
+		response.Results = append(response.Results, &v1pb.ImportShortcutsResponse_Result{
+			Name:         result.Name,
+			ImportedName: result.ImportedName,
+			Action:       action,
+			ShortcutId:   result.ShortcutID,
+			Message:      result.Message,
+		})
+	}
+	if !request.DryRun && response.CreatedCount > 0 {
+		metric.Enqueue("shortcut import")
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	var userID *int32
+	if v, ok := ctx.Value(userIDContextKey).(int32); ok {
+		userID = &v
+	}
+	visible, err := IsShortcutVisible(ctx, s.Store, shortcut, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	granularity := request.Granularity
+	if granularity == v1pb.Granularity_GRANULARITY_UNSPECIFIED {
+		granularity = v1pb.Granularity_DAY
+	}
+	var startTs *int64
+	if request.StartTime != nil {
+		ts := request.StartTime.AsTime().Unix()
+		startTs = &ts
+	}
+	endTs := time.Now().Unix()
+	if request.EndTime != nil {
+		endTs = request.EndTime.AsTime().Unix()
+	}
+	// The hourly views are counted from the raw activities, so their range is always bounded.
+	if granularity == v1pb.Granularity_HOUR && startTs == nil {
+		ts := endTs - defaultHourlyRangeSeconds
+		startTs = &ts
+	}
+	if startTs != nil && *startTs >= endTs {
+		return nil, status.Errorf(codes.InvalidArgument, "start time must be before end time")
+	}
+	if granularity == v1pb.Granularity_HOUR && (endTs-*startTs)/secondsPerHour > maxTimeSeriesBucketCount {
+		return nil, status.Errorf(codes.InvalidArgument, "too many buckets in the time range, use a larger granularity")
+	}
 
-		if _, ok := browserMap[browserName]; !ok {
-			browserMap[browserName] = 0
+	referenceMap := make(map[string]int32)
+	deviceMap := make(map[string]int32)
+	browserMap := make(map[string]int32)
+	viewMap := make(map[int64]int32)
+	if granularity == v1pb.Granularity_HOUR {
+		// The view stats are daily, so hourly views are counted from the raw activities.
+		activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
+			Type:              store.ActivityShortcutView,
+			PayloadShortcutID: &shortcut.Id,
+			CreatedTsAfter:    startTs,
+			CreatedTsBefore:   &endTs,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+		}
+		for _, activity := range activities {
+			stat, err := store.ConvertActivityToShortcutViewStat(activity)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert activity, err: %v", err)
+			}
+			referenceMap[stat.Referer]++
+			deviceMap[stat.Device]++
+			browserMap[stat.Browser]++
+			viewMap[truncateToBucket(activity.CreatedTs, granularity)]++
+		}
+	} else {
+		find := &store.FindShortcutViewStat{
+			ShortcutID:  &shortcut.Id,
+			DayTsBefore: &endTs,
+		}
+		if startTs != nil {
+			dayTs := store.GetDayTs(*startTs)
+			find.DayTsAfter = &dayTs
+		}
+		stats, err := s.Store.ListShortcutViewStats(ctx, find)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list shortcut view stats, err: %v", err)
+		}
+		for _, stat := range stats {
+			referenceMap[stat.Referer] += stat.Count
+			deviceMap[stat.Device] += stat.Count
+			browserMap[stat.Browser] += stat.Count
+			viewMap[truncateToBucket(stat.DayTs, granularity)] += stat.Count
 		}
-		browserMap[browserName]++
+	}
+	buckets, err := getTimeSeriesBuckets(viewMap, startTs, endTs, granularity)
+	if err != nil {
+		return nil, err
+	}
+	views := []*v1pb.GetShortcutAnalyticsResponse_TimeSeriesItem{}
+	for _, bucket := range buckets {
+		views = append(views, &v1pb.GetShortcutAnalyticsResponse_TimeSeriesItem{
+			Time:  timestamppb.New(time.Unix(bucket, 0)),
+			Count: viewMap[bucket],
+		})
 	}
 
 	metric.Enqueue("shortcut analytics")
@@ -330,10 +1128,51 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 		References: mapToAnalyticsSlice(referenceMap),
 		Devices:    mapToAnalyticsSlice(deviceMap),
 		Browsers:   mapToAnalyticsSlice(browserMap),
+		Views:      views,
 	}
 	return response, nil
 }
 
+// IsShortcutVisible returns true if the shortcut can be seen by the user with the given id.
+// A nil userID stands for an anonymous visitor, who can only see public shortcuts.
+func IsShortcutVisible(ctx context.Context, s *store.Store, shortcut *storepb.Shortcut, userID *int32) (bool, error) {
+	return isResourceVisible(ctx, s, store.CollaboratorResourceTypeShortcut, shortcut.Id, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupIds, userID)
+}
+
+// isResourceVisible returns true if a shortcut or a collection can be seen by the user with the given id.
+// The resources shared with groups are only visible to their creator and the members of the groups,
+// and the collaborators of a resource can see it whatever its visibility.
+func isResourceVisible(ctx context.Context, s *store.Store, resourceType store.CollaboratorResourceType, resourceID int32, visibility storepb.Visibility, creatorID int32, groupIDs []int32, userID *int32) (bool, error) {
+	if userID == nil {
+		return visibility == storepb.Visibility_PUBLIC, nil
+	}
+	switch visibility {
+	case storepb.Visibility_PRIVATE:
+		if creatorID == *userID {
+			return true, nil
+		}
+	case storepb.Visibility_GROUP:
+		if creatorID == *userID {
+			return true, nil
+		}
+		isMember, err := s.IsGroupMember(ctx, *userID, groupIDs)
+		if err != nil || isMember {
+			return isMember, err
+		}
+	default:
+		return true, nil
+	}
+	collaborator, err := s.GetCollaborator(ctx, &store.FindCollaborator{
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+		UserID:       userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return collaborator != nil, nil
+}
+
 func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem {
 	analyticsSlice := make([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, 0)
 	for key, value := range m {
@@ -354,11 +1193,16 @@ func (s *APIV1Service) createShortcutViewActivity(ctx context.Context, shortcut
 	if !ok {
 		return errors.New("Failed to get metadata from context")
 	}
+	return CreateShortcutViewActivity(ctx, s.Store, shortcut, p.Addr.String(), headers.Get("referer")[0], headers.Get("user-agent")[0])
+}
+
+// CreateShortcutViewActivity records a view of the shortcut by a visitor, from either the API or a redirect.
+func CreateShortcutViewActivity(ctx context.Context, s *store.Store, shortcut *storepb.Shortcut, ip, referer, userAgent string) error {
 	payload := &storepb.ActivityShorcutViewPayload{
 		ShortcutId: shortcut.Id,
-		Ip:         p.Addr.String(),
-		Referer:    headers.Get("referer")[0],
-		UserAgent:  headers.Get("user-agent")[0],
+		Ip:         ip,
+		Referer:    referer,
+		UserAgent:  userAgent,
 	}
 	payloadStr, err := protojson.Marshal(payload)
 	if err != nil {
@@ -370,7 +1214,7 @@ func (s *APIV1Service) createShortcutViewActivity(ctx context.Context, shortcut
 		Level:     store.ActivityInfo,
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
+	_, err = s.CreateActivity(ctx, activity)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create activity")
 	}
@@ -398,6 +1242,229 @@ func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcu
 	return nil
 }
 
+// applyShortcutFilter applies the conditions of the filter expression to the find.
+func applyShortcutFilter(find *store.FindShortcut, filterExpr string) error {
+	conditions, err := filter.Parse(filterExpr)
+	if err != nil {
+		return err
+	}
+	for _, condition := range conditions {
+		switch {
+		case condition.Field == "tag" && condition.Operator == "==":
+			find.Tag = &condition.Value
+		case condition.Field == "creator" && condition.Operator == "==":
+			creatorID, err := util.ConvertStringToInt32(strings.TrimPrefix(condition.Value, UserNamePrefix))
+			if err != nil {
+				return errors.Errorf("invalid creator %q", condition.Value)
+			}
+			find.CreatorID = &creatorID
+		case condition.Field == "visibility" && condition.Operator == "==":
+			if _, ok := v1pb.Visibility_value[condition.Value]; !ok || condition.Value == v1pb.Visibility_VISIBILITY_UNSPECIFIED.String() {
+				return errors.Errorf("invalid visibility %q", condition.Value)
+			}
+			find.VisibilityList = []store.Visibility{store.Visibility(condition.Value)}
+		case condition.Field == "created_time" || condition.Field == "updated_time":
+			t, err := time.Parse(time.RFC3339, condition.Value)
+			if err != nil {
+				return errors.Errorf("invalid time %q", condition.Value)
+			}
+			after, before := &find.CreatedTsAfter, &find.CreatedTsBefore
+			if condition.Field == "updated_time" {
+				after, before = &find.UpdatedTsAfter, &find.UpdatedTsBefore
+			}
+			ts := t.Unix()
+			switch condition.Operator {
+			case "==":
+				next := ts + 1
+				*after, *before = &ts, &next
+			case ">=":
+				*after = &ts
+			case ">":
+				ts++
+				*after = &ts
+			case "<":
+				*before = &ts
+			case "<=":
+				ts++
+				*before = &ts
+			default:
+				return errors.Errorf("unsupported operator %q for %s", condition.Operator, condition.Field)
+			}
+		case condition.Field == "name" && condition.Operator == "startsWith":
+			find.NamePrefix = &condition.Value
+		default:
+			return errors.Errorf("unsupported condition %s %s %q", condition.Field, condition.Operator, condition.Value)
+		}
+	}
+	return nil
+}
+
+// applyShortcutOrderBy applies the order by expression, e.g. "view_count desc", to the find.
+func applyShortcutOrderBy(find *store.FindShortcut, orderBy string) error {
+	if orderBy == "" {
+		return nil
+	}
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return errors.Errorf("empty order by")
+	}
+	if len(parts) > 2 {
+		return errors.Errorf("only one order by field is supported")
+	}
+	switch parts[0] {
+	case "name":
+		find.OrderBy = store.ShortcutOrderByName
+	case "created_time":
+		find.OrderBy = store.ShortcutOrderByCreatedTs
+	case "updated_time":
+		find.OrderBy = store.ShortcutOrderByUpdatedTs
+	case "view_count":
+		find.OrderBy = store.ShortcutOrderByViewCount
+	default:
+		return errors.Errorf("unsupported order by field %q", parts[0])
+	}
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+			find.OrderDesc = false
+		case "desc":
+			find.OrderDesc = true
+		default:
+			return errors.Errorf("invalid order direction %q", parts[1])
+		}
+	}
+	return nil
+}
+
+// IsShortcutActive returns true if the given time is within the active window of the shortcut.
+func IsShortcutActive(shortcut *storepb.Shortcut, now time.Time) bool {
+	if shortcut.StartTs != 0 && now.Unix() < shortcut.StartTs {
+		return false
+	}
+	if shortcut.ExpireTs != 0 && now.Unix() >= shortcut.ExpireTs {
+		return false
+	}
+	return true
+}
+
+func validateShortcutWindow(startTs, expireTs int64) error {
+	if startTs != 0 && expireTs != 0 && expireTs <= startTs {
+		return status.Errorf(codes.InvalidArgument, "expire time must be after start time")
+	}
+	return nil
+}
+
+// isShortcutUnexpired reports whether the shortcut archived by the expiration job is active again
+// with the new expire time, which is then either unset or in the future.
+func isShortcutUnexpired(shortcut *storepb.Shortcut, expireTs int64) bool {
+	return shortcut.RowStatus == storepb.RowStatus_ARCHIVED && (expireTs == 0 || expireTs > time.Now().Unix())
+}
+
+// checkShortcutNamesAvailable checks that the names are distinct and not used as the name or an alias
+// by any shortcut other than the one with the given id, including the shortcuts in the trash.
+func (s *APIV1Service) checkShortcutNamesAvailable(ctx context.Context, shortcutID int32, names []string) error {
+	nameSet := map[string]bool{}
+	for _, name := range names {
+		if name == "" {
+			return status.Errorf(codes.InvalidArgument, "name and aliases cannot be empty")
+		}
+		if nameSet[name] {
+			return status.Errorf(codes.InvalidArgument, "duplicated name %q", name)
+		}
+		nameSet[name] = true
+
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name:           &name,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if shortcut != nil && shortcut.Id != shortcutID {
+			if shortcut.DeletedTs != 0 {
+				return status.Errorf(codes.AlreadyExists, "name %q is already used by shortcut %q in the trash", name, shortcut.Name)
+			}
+			return status.Errorf(codes.AlreadyExists, "name %q is already used by shortcut %q", name, shortcut.Name)
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) convertShortcutRevisionFromStore(ctx context.Context, revision *store.ShortcutRevision) (*v1pb.ShortcutRevision, error) {
+	composedRevision := &v1pb.ShortcutRevision{
+		Id:         revision.ID,
+		ShortcutId: revision.ShortcutID,
+		EditorId:   revision.EditorID,
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Changes:    getShortcutRevisionChanges(revision.Previous, revision.Current),
+	}
+	if revision.Previous != nil {
+		previous := revision.Previous
+		if previous.OgMetadata == nil {
+			previous.OgMetadata = &storepb.OpenGraphMetadata{}
+		}
+		previousShortcut, err := s.convertShortcutFromStorepb(ctx, previous)
+		if err != nil {
+			return nil, err
+		}
+		composedRevision.PreviousShortcut = previousShortcut
+	}
+	return composedRevision, nil
+}
+
+// getShortcutRevisionChanges returns the fields which differ between the shortcuts before and after an edit.
+func getShortcutRevisionChanges(previous, current *storepb.Shortcut) []*v1pb.ShortcutRevision_Change {
+	if previous == nil || current == nil {
+		return []*v1pb.ShortcutRevision_Change{}
+	}
+	formatTs := func(ts int64) string {
+		if ts == 0 {
+			return ""
+		}
+		return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+	}
+	formatIDs := func(ids []int32) string {
+		list := []string{}
+		for _, id := range ids {
+			list = append(list, fmt.Sprint(id))
+		}
+		return strings.Join(list, ", ")
+	}
+	previousOgMetadata, currentOgMetadata := previous.GetOgMetadata(), current.GetOgMetadata()
+	fields := []struct {
+		name     string
+		oldValue string
+		newValue string
+	}{
+		{"name", previous.Name, current.Name},
+		{"link", previous.Link, current.Link},
+		{"title", previous.Title, current.Title},
+		{"description", previous.Description, current.Description},
+		{"tags", strings.Join(previous.Tags, ", "), strings.Join(current.Tags, ", ")},
+		{"visibility", previous.Visibility.String(), current.Visibility.String()},
+		{"aliases", strings.Join(previous.Aliases, ", "), strings.Join(current.Aliases, ", ")},
+		{"group_ids", formatIDs(previous.GroupIds), formatIDs(current.GroupIds)},
+		{"og_metadata.title", previousOgMetadata.GetTitle(), currentOgMetadata.GetTitle()},
+		{"og_metadata.description", previousOgMetadata.GetDescription(), currentOgMetadata.GetDescription()},
+		{"og_metadata.image", previousOgMetadata.GetImage(), currentOgMetadata.GetImage()},
+		{"start_time", formatTs(previous.StartTs), formatTs(current.StartTs)},
+		{"expire_time", formatTs(previous.ExpireTs), formatTs(current.ExpireTs)},
+		{"fallback_link", previous.FallbackLink, current.FallbackLink},
+		{"row_status", previous.RowStatus.String(), current.RowStatus.String()},
+	}
+	changes := []*v1pb.ShortcutRevision_Change{}
+	for _, field := range fields {
+		if field.oldValue != field.newValue {
+			changes = append(changes, &v1pb.ShortcutRevision_Change{
+				Field:    field.name,
+				OldValue: field.oldValue,
+				NewValue: field.newValue,
+			})
+		}
+	}
+	return changes
+}
+
 func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
 	composedShortcut := &v1pb.Shortcut{
 		Id:          shortcut.Id,
@@ -416,17 +1483,27 @@ func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 			Description: shortcut.OgMetadata.Description,
 			Image:       shortcut.OgMetadata.Image,
 		},
+		Aliases:      shortcut.Aliases,
+		FallbackLink: shortcut.FallbackLink,
+		GroupIds:     shortcut.GroupIds,
+	}
+	if shortcut.StartTs != 0 {
+		composedShortcut.StartTime = timestamppb.New(time.Unix(shortcut.StartTs, 0))
+	}
+	if shortcut.ExpireTs != 0 {
+		composedShortcut.ExpireTime = timestamppb.New(time.Unix(shortcut.ExpireTs, 0))
+	}
+	if shortcut.DeletedTs != 0 {
+		composedShortcut.DeleteTime = timestamppb.New(time.Unix(shortcut.DeletedTs, 0))
 	}
 
-	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		Level:             store.ActivityInfo,
-		PayloadShortcutID: &composedShortcut.Id,
+	viewCount, err := s.Store.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutID: &composedShortcut.Id,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to list activities")
+		return nil, errors.Wrap(err, "Failed to count shortcut views")
 	}
-	composedShortcut.ViewCount = int32(len(activityList))
+	composedShortcut.ViewCount = viewCount
 
 	return composedShortcut, nil
 }
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestApplyShortcutOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy   string
+		want      store.ShortcutOrderBy
+		wantDesc  bool
+		wantError bool
+	}{
+		{
+			orderBy: "",
+		},
+		{
+			orderBy: "name",
+			want:    store.ShortcutOrderByName,
+		},
+		{
+			orderBy:  "view_count desc",
+			want:     store.ShortcutOrderByViewCount,
+			wantDesc: true,
+		},
+		{
+			orderBy: " updated_time  ASC ",
+			want:    store.ShortcutOrderByUpdatedTs,
+		},
+		{
+			orderBy:   "   ",
+			wantError: true,
+		},
+		{
+			orderBy:   "title",
+			wantError: true,
+		},
+		{
+			orderBy:   "name sideways",
+			wantError: true,
+		},
+		{
+			orderBy:   "name desc, view_count",
+			wantError: true,
+		},
+	}
+	for _, test := range tests {
+		find := &store.FindShortcut{}
+		err := applyShortcutOrderBy(find, test.orderBy)
+		if test.wantError {
+			require.Error(t, err, "order by %q", test.orderBy)
+			continue
+		}
+		require.NoError(t, err, "order by %q", test.orderBy)
+		require.Equal(t, test.want, find.OrderBy, "order by %q", test.orderBy)
+		require.Equal(t, test.wantDesc, find.OrderDesc, "order by %q", test.orderBy)
+	}
+}
+
+func TestUpdateShortcutUnarchivesExtendedShortcut(t *testing.T) {
+	ctx := context.Background()
+	service := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, service.Store, store.RoleUser, "jane@example.com", "secret")
+	ctx = context.WithValue(ctx, userIDContextKey, user.ID)
+	shortcut, err := service.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "launch",
+		Link:       "https://launch.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ExpireTs:   time.Now().Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	// The expiration job archived the shortcut.
+	archived := store.Archived
+	_, err = service.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+
+	// Another past expire time keeps the shortcut archived.
+	response, err := service.UpdateShortcut(ctx, &v1pb.UpdateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Id:         shortcut.Id,
+			ExpireTime: timestamppb.New(time.Now().Add(-time.Minute)),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RowStatus_ARCHIVED, response.Shortcut.RowStatus)
+
+	// Extending the window makes the shortcut normal again.
+	response, err = service.UpdateShortcut(ctx, &v1pb.UpdateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Id:         shortcut.Id,
+			ExpireTime: timestamppb.New(time.Now().Add(time.Hour)),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RowStatus_NORMAL, response.Shortcut.RowStatus)
+	shortcut, err = service.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, shortcut.RowStatus)
+}
+
+func TestGetShortcutAnalytics(t *testing.T) {
+	ctx := context.Background()
+	service := newTestingService(ctx, t)
+	creator := createTestingUser(ctx, t, service.Store, store.RoleUser, "jane@example.com", "secret")
+	other := createTestingUser(ctx, t, service.Store, store.RoleUser, "john@example.com", "secret")
+	shortcut, err := service.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  creator.ID,
+		Name:       "private",
+		Link:       "https://private.example.com",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, CreateShortcutViewActivity(ctx, service.Store, shortcut, "127.0.0.1", "https://example.com", ""))
+
+	// The analytics of the shortcuts which the user cannot see are not returned.
+	_, err = service.GetShortcutAnalytics(context.WithValue(ctx, userIDContextKey, other.ID), &v1pb.GetShortcutAnalyticsRequest{
+		Id: shortcut.Id,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The hourly views default to the 7 days before the end time.
+	response, err := service.GetShortcutAnalytics(context.WithValue(ctx, userIDContextKey, creator.ID), &v1pb.GetShortcutAnalyticsRequest{
+		Id:          shortcut.Id,
+		EndTime:     timestamppb.New(time.Now().Add(time.Hour)),
+		Granularity: v1pb.Granularity_HOUR,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 7*24+1, len(response.Views))
+	viewCount := int32(0)
+	for _, view := range response.Views {
+		viewCount += view.Count
+	}
+	require.Equal(t, int32(1), viewCount)
+}
@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/idp/oidc"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// ssoPath is the path of the single sign-on routes, which are served by echo instead of the gateway.
+	ssoPath = "/api/v1/auth/sso"
+	// SSOSessionAudienceName is the audience name of the single sign-on session.
+	SSOSessionAudienceName = "user.sso-session"
+	// SSOSessionDuration is the time the user has to sign in with the identity provider.
+	SSOSessionDuration = 10 * time.Minute
+	// SSOSessionCookieName is the cookie name of the single sign-on session.
+	SSOSessionCookieName = "slash.sso-session"
+)
+
+// ssoSessionClaims are the claims of the single sign-on session, kept in a signed cookie
+// from the redirect to the identity provider until the callback.
+type ssoSessionClaims struct {
+	oidc.Session
+	ProviderID string `json:"providerId"`
+	// Redirect is the path the user is redirected to once signed in.
+	Redirect string `json:"redirect"`
+	jwt.RegisteredClaims
+}
+
+func (s *APIV1Service) registerSSORoutes(e *echo.Echo) {
+	// Redirects the user to the identity provider, e.g. "/api/v1/auth/sso/okta?redirect=/shortcuts".
+	e.GET(ssoPath+"/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, oidcProvider, err := s.getOIDCIdentityProvider(c)
+		if err != nil {
+			return err
+		}
+
+		session, err := oidc.NewSession()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create session").SetInternal(err)
+		}
+		callbackURL, err := s.getSSOCallbackURL(c, identityProvider.Id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get callback url").SetInternal(err)
+		}
+		authCodeURL, err := oidcProvider.AuthCodeURL(ctx, callbackURL, session)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadGateway, "failed to reach the identity provider").SetInternal(err)
+		}
+
+		expiresAt := time.Now().Add(SSOSessionDuration)
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ssoSessionClaims{
+			Session:    *session,
+			ProviderID: identityProvider.Id,
+			Redirect:   getSafeRedirect(c.QueryParam("redirect")),
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    Issuer,
+				Audience:  jwt.ClaimStrings{SSOSessionAudienceName},
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		})
+		token.Header["kid"] = KeyID
+		sessionToken, err := token.SignedString([]byte(s.Secret))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to sign session").SetInternal(err)
+		}
+		// The callback is a cross-site navigation from the identity provider, so the cookie is lax.
+		c.SetCookie(&http.Cookie{
+			Name:     SSOSessionCookieName,
+			Value:    sessionToken,
+			Path:     ssoPath,
+			Expires:  expiresAt,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		return c.Redirect(http.StatusFound, authCodeURL)
+	})
+
+	// Signs in the user redirected back by the identity provider.
+	e.GET(ssoPath+"/:id/callback", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, oidcProvider, err := s.getOIDCIdentityProvider(c)
+		if err != nil {
+			return err
+		}
+		if errorCode := c.QueryParam("error"); errorCode != "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("the identity provider returned an error: %s %s", errorCode, c.QueryParam("error_description")))
+		}
+
+		session, err := s.getSSOSession(c, identityProvider.Id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid sign-in session").SetInternal(err)
+		}
+		// The session is used only once.
+		c.SetCookie(&http.Cookie{
+			Name:     SSOSessionCookieName,
+			Path:     ssoPath,
+			MaxAge:   -1,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		callbackURL, err := s.getSSOCallbackURL(c, identityProvider.Id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get callback url").SetInternal(err)
+		}
+		userInfo, err := oidcProvider.UserInfo(ctx, callbackURL, c.QueryParam("code"), &session.Session)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "failed to verify the identity").SetInternal(err)
+		}
+		user, err := s.IdentityProviderService.SignIn(ctx, identityProvider, userInfo)
+		if err != nil {
+			if errors.Is(err, idp.ErrUserNotProvisioned) || errors.Is(err, idp.ErrUserArchived) || errors.Is(err, idp.ErrEmailNotVerified) {
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			} else if errors.Is(err, idp.ErrEmailTaken) {
+				return echo.NewHTTPError(http.StatusConflict, err.Error())
+			}
+			slog.Error("failed to sign in with identity provider", slog.String("provider", identityProvider.Id), slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusUnauthorized, "failed to sign in").SetInternal(err)
+		}
+
+		expiresAt := time.Now().Add(AccessTokenDuration)
+		accessToken, err := GenerateAccessToken(user.Email, user.ID, expiresAt, []byte(s.Secret))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate tokens").SetInternal(err)
+		}
+		if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, "user login"); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to upsert access token to store").SetInternal(err)
+		}
+		c.SetCookie(&http.Cookie{
+			Name:     AccessTokenCookieName,
+			Value:    accessToken,
+			Path:     "/",
+			Expires:  expiresAt,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+
+		metric.Enqueue("user sso sign in")
+		return c.Redirect(http.StatusFound, session.Redirect)
+	})
+}
+
+// getOIDCIdentityProvider returns the OpenID Connect identity provider of the id in the path.
+func (s *APIV1Service) getOIDCIdentityProvider(c echo.Context) (*storepb.IdentityProvider, *oidc.IdentityProvider, error) {
+	identityProvider, err := s.IdentityProviderService.GetIdentityProvider(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get identity provider").SetInternal(err)
+	}
+	if identityProvider == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("identity provider not found: %s", c.Param("id")))
+	}
+	config := identityProvider.GetOidc()
+	if config == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("identity provider %s does not support redirects", identityProvider.Id))
+	}
+	oidcProvider, err := oidc.NewIdentityProvider(config)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "invalid identity provider").SetInternal(err)
+	}
+	return identityProvider, oidcProvider, nil
+}
+
+// getSSOSession returns the session of the cookie, after checking it matches the provider and the state of the callback.
+func (s *APIV1Service) getSSOSession(c echo.Context, providerID string) (*ssoSessionClaims, error) {
+	cookie, err := c.Cookie(SSOSessionCookieName)
+	if err != nil {
+		return nil, errors.Wrap(err, "missing session cookie")
+	}
+	claims := &ssoSessionClaims{}
+	if _, err := jwt.ParseWithClaims(cookie.Value, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); !ok || kid != KeyID {
+			return nil, errors.Errorf("unexpected kid: %v", t.Header["kid"])
+		}
+		return []byte(s.Secret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(SSOSessionAudienceName),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return nil, err
+	}
+	if claims.ProviderID != providerID {
+		return nil, errors.New("the session belongs to another identity provider")
+	}
+	if claims.State == "" || claims.State != c.QueryParam("state") {
+		return nil, errors.New("state does not match")
+	}
+	return claims, nil
+}
+
+// getSSOCallbackURL returns the url the identity provider redirects the user back to,
+// on the instance url if it is set.
+func (s *APIV1Service) getSSOCallbackURL(c echo.Context, providerID string) (string, error) {
+	instanceURLSetting, err := s.Store.GetWorkspaceSetting(c.Request().Context(), &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INSTANCE_URL,
+	})
+	if err != nil {
+		return "", err
+	}
+	baseURL := strings.TrimSuffix(instanceURLSetting.GetInstanceUrl(), "/")
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("%s://%s", c.Scheme(), c.Request().Host)
+	}
+	return fmt.Sprintf("%s%s/%s/callback", baseURL, ssoPath, providerID), nil
+}
+
+// getSafeRedirect returns the redirect if it is a path of the instance, and the root path otherwise.
+func getSafeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
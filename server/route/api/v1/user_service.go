@@ -93,7 +93,7 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get current user: %v", err)
 	}
-	if user.ID != request.User.Id {
+	if user.ID != request.User.Id && user.Role != store.RoleAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
@@ -108,8 +108,26 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			userUpdate.Email = &request.User.Email
 		} else if path == "nickname" {
 			userUpdate.Nickname = &request.User.Nickname
+		} else if path == "row_status" {
+			if user.Role != store.RoleAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+			}
+			rowStatus := convertRowStatusToStore(request.User.RowStatus)
+			if rowStatus == store.Archived && user.ID == request.User.Id {
+				return nil, status.Errorf(codes.InvalidArgument, "cannot archive yourself")
+			}
+			userUpdate.RowStatus = &rowStatus
 		}
 	}
+	if request.TransferTo != 0 {
+		if userUpdate.RowStatus == nil || *userUpdate.RowStatus != store.Archived {
+			return nil, status.Errorf(codes.InvalidArgument, "transfer_to is only supported when archiving the user")
+		}
+		if err := s.validateTransferTarget(ctx, request.User.Id, request.TransferTo); err != nil {
+			return nil, err
+		}
+		userUpdate.TransferTo = &request.TransferTo
+	}
 	user, err = s.Store.UpdateUser(ctx, userUpdate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
@@ -128,13 +146,65 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 		return nil, status.Errorf(codes.InvalidArgument, "cannot delete yourself")
 	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{ID: request.Id}); err != nil {
+	userDelete := &store.DeleteUser{
+		ID: request.Id,
+	}
+	if request.TransferTo != 0 {
+		if err := s.validateTransferTarget(ctx, request.Id, request.TransferTo); err != nil {
+			return nil, err
+		}
+		userDelete.TransferTo = &request.TransferTo
+	}
+	if err := s.Store.DeleteUser(ctx, userDelete); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
 	response := &v1pb.DeleteUserResponse{}
 	return response, nil
 }
 
+func (s *APIV1Service) TransferUserResources(ctx context.Context, request *v1pb.TransferUserResourcesRequest) (*v1pb.TransferUserResourcesResponse, error) {
+	if err := s.validateTransferTarget(ctx, request.Id, request.TransferTo); err != nil {
+		return nil, err
+	}
+	if err := s.Store.TransferUserResources(ctx, &store.TransferUserResources{
+		FromUserID: request.Id,
+		ToUserID:   request.TransferTo,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer user resources: %v", err)
+	}
+	response := &v1pb.TransferUserResourcesResponse{}
+	return response, nil
+}
+
+// validateTransferTarget checks that the resources of a user can be transferred to another active user.
+func (s *APIV1Service) validateTransferTarget(ctx context.Context, fromUserID, toUserID int32) error {
+	if fromUserID == toUserID {
+		return status.Errorf(codes.InvalidArgument, "cannot transfer resources to the same user")
+	}
+	fromUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &fromUserID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if fromUser == nil {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	toUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &toUserID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if toUser == nil {
+		return status.Errorf(codes.NotFound, "transfer target user not found")
+	}
+	if toUser.RowStatus == store.Archived {
+		return status.Errorf(codes.InvalidArgument, "cannot transfer resources to an archived user")
+	}
+	return nil
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
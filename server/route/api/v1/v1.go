@@ -13,6 +13,10 @@ import (
 
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/server/service/archive"
+	"github.com/yourselfhosted/slash/server/service/backup"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/importer"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
@@ -25,31 +29,47 @@ type APIV1Service struct {
 	v1pb.UnimplementedUserSettingServiceServer
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedCollectionServiceServer
+	v1pb.UnimplementedAnalyticsServiceServer
+	v1pb.UnimplementedBackupServiceServer
+	v1pb.UnimplementedGroupServiceServer
 
-	Secret         string
-	Profile        *profile.Profile
-	Store          *store.Store
-	LicenseService *license.LicenseService
+	Secret                  string
+	Profile                 *profile.Profile
+	Store                   *store.Store
+	LicenseService          *license.LicenseService
+	BackupService           *backup.BackupService
+	ImportService           *importer.ImportService
+	ArchiveService          *archive.ArchiveService
+	IdentityProviderService *idp.IdentityProviderService
 
 	grpcServer     *grpc.Server
 	grpcServerPort int
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, backupService *backup.BackupService, grpcServerPort int) *APIV1Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
+	loggerInterceptor := NewLoggerInterceptor()
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			NewLoggerInterceptor().LoggerInterceptor,
+			loggerInterceptor.LoggerInterceptor,
 			authProvider.AuthenticationInterceptor,
 		),
+		grpc.ChainStreamInterceptor(
+			loggerInterceptor.LoggerStreamInterceptor,
+			authProvider.AuthenticationStreamInterceptor,
+		),
 	)
 	apiV1Service := &APIV1Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:                  secret,
+		Profile:                 profile,
+		Store:                   store,
+		LicenseService:          licenseService,
+		BackupService:           backupService,
+		ImportService:           importer.NewImportService(store),
+		ArchiveService:          archive.NewArchiveService(profile, store),
+		IdentityProviderService: idp.NewIdentityProviderService(store, licenseService),
+		grpcServer:              grpcServer,
+		grpcServerPort:          grpcServerPort,
 	}
 
 	v1pb.RegisterSubscriptionServiceServer(grpcServer, apiV1Service)
@@ -59,6 +79,9 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterUserSettingServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterCollectionServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterAnalyticsServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterBackupServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiV1Service)
 	reflection.Register(grpcServer)
 
 	return apiV1Service
@@ -102,6 +125,17 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	if err := v1pb.RegisterCollectionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterAnalyticsServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterBackupServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterGroupServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	// The single sign-on routes are plain redirects, served before the gateway.
+	s.registerSSORoutes(e)
 	e.Any("/api/v1/*", echo.WrapHandler(gwMux))
 
 	// GRPC web proxy.
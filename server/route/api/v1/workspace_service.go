@@ -3,13 +3,19 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"sync"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yourselfhosted/slash/internal/cron"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/archive"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -38,6 +44,17 @@ func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorks
 		profile.CustomScript = setting.GetCustomScript()
 		profile.FaviconProvider = setting.GetFaviconProvider()
 	}
+	// Only the ids and titles of the identity providers are public, for the sign-in page.
+	identityProviders, err := s.IdentityProviderService.ListIdentityProviders(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list identity providers: %v", err)
+	}
+	for _, identityProvider := range identityProviders {
+		profile.IdentityProviders = append(profile.IdentityProviders, &v1pb.IdentityProvider{
+			Id:    identityProvider.Id,
+			Title: identityProvider.Title,
+		})
+	}
 	owner, err := s.GetInstanceOwner(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get instance owner: %v", err)
@@ -83,10 +100,25 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 			workspaceSetting.DefaultVisibility = v1pb.Visibility(v.GetDefaultVisibility())
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FAVICON_PROVIDER {
 			workspaceSetting.FaviconProvider = v.GetFaviconProvider()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS {
+			workspaceSetting.TrashRetentionDays = v.GetTrashRetentionDays()
 		} else if isAdmin {
 			// For some settings, only admin can get the value.
 			if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY {
 				workspaceSetting.LicenseKey = v.GetLicenseKey()
+			} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP {
+				autoBackup := v.GetAutoBackup()
+				workspaceSetting.AutoBackup = &v1pb.AutoBackupWorkspaceSetting{
+					Enabled:        autoBackup.GetEnabled(),
+					CronExpression: autoBackup.GetCronExpression(),
+					MaxKeep:        autoBackup.GetMaxKeep(),
+				}
+			} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS {
+				for _, identityProvider := range v.GetIdentityProviders().GetIdentityProviders() {
+					workspaceSetting.IdentityProviders = append(workspaceSetting.IdentityProviders, convertIdentityProviderFromStore(identityProvider))
+				}
+			} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP {
+				workspaceSetting.Ldap = convertLDAPSettingFromStore(v.GetLdap())
 			}
 		}
 	}
@@ -164,6 +196,84 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "trash_retention_days" {
+			if request.Setting.TrashRetentionDays < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "trash retention days must not be negative")
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS,
+				Value: &storepb.WorkspaceSetting_TrashRetentionDays{
+					TrashRetentionDays: request.Setting.TrashRetentionDays,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "auto_backup" {
+			autoBackup := request.Setting.AutoBackup
+			if autoBackup == nil {
+				autoBackup = &v1pb.AutoBackupWorkspaceSetting{}
+			}
+			if autoBackup.Enabled {
+				if _, err := cron.NewSchedule(autoBackup.CronExpression); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid cron expression: %v", err)
+				}
+			}
+			if autoBackup.MaxKeep < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "max keep must not be negative")
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP,
+				Value: &storepb.WorkspaceSetting_AutoBackup{
+					AutoBackup: &storepb.AutoBackupWorkspaceSetting{
+						Enabled:        autoBackup.Enabled,
+						CronExpression: autoBackup.CronExpression,
+						MaxKeep:        autoBackup.MaxKeep,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+			if err := s.BackupService.RegisterAutoBackup(ctx); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to register auto backup: %v", err)
+			}
+		} else if path == "ldap" {
+			ldapSetting := convertLDAPSettingToStore(request.Setting.Ldap)
+			if ldapSetting.Enabled {
+				if _, err := ldap.NewAuthenticator(ldapSetting); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid ldap setting: %v", err)
+				}
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP,
+				Value: &storepb.WorkspaceSetting_Ldap{
+					Ldap: ldapSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "identity_providers" {
+			identityProviders := []*storepb.IdentityProvider{}
+			ids := map[string]bool{}
+			for _, identityProvider := range request.Setting.IdentityProviders {
+				if err := validateIdentityProvider(identityProvider); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid identity provider %q: %v", identityProvider.Id, err)
+				}
+				if ids[identityProvider.Id] {
+					return nil, status.Errorf(codes.InvalidArgument, "duplicate identity provider id: %s", identityProvider.Id)
+				}
+				ids[identityProvider.Id] = true
+				identityProviders = append(identityProviders, convertIdentityProviderToStore(identityProvider))
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS,
+				Value: &storepb.WorkspaceSetting_IdentityProviders{
+					IdentityProviders: &storepb.IdentityProvidersWorkspaceSetting{
+						IdentityProviders: identityProviders,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -178,9 +288,147 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	}, nil
 }
 
-var ownerCache *v1pb.User
+func (s *APIV1Service) ExportWorkspace(ctx context.Context, request *v1pb.ExportWorkspaceRequest) (*v1pb.ExportWorkspaceResponse, error) {
+	workspaceArchive, err := s.ArchiveService.Export(ctx, &archive.ExportOptions{
+		IncludeSecrets:    request.IncludeSecrets,
+		IncludeActivities: request.IncludeActivities,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export workspace: %v", err)
+	}
+	content, err := archive.Marshal(workspaceArchive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal archive: %v", err)
+	}
+	return &v1pb.ExportWorkspaceResponse{
+		Content:  content,
+		Filename: archive.GetFileName(workspaceArchive),
+	}, nil
+}
+
+// identityProviderIDRegexp matches the ids of the identity providers, which are part of their sign-in urls.
+var identityProviderIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+func validateIdentityProvider(identityProvider *v1pb.IdentityProvider) error {
+	if !identityProviderIDRegexp.MatchString(identityProvider.Id) {
+		return errors.New("id must consist of lowercase letters, digits and hyphens")
+	}
+	if identityProvider.Id == ldap.ProviderID {
+		return errors.Errorf("id %s is reserved for the ldap authentication", ldap.ProviderID)
+	}
+	if identityProvider.Title == "" {
+		return errors.New("title is required")
+	}
+	switch config := identityProvider.Config.(type) {
+	case *v1pb.IdentityProvider_Oidc:
+		if config.Oidc.GetIssuer() == "" || config.Oidc.GetClientId() == "" {
+			return errors.New("issuer and client id are required")
+		}
+		if _, err := url.ParseRequestURI(config.Oidc.Issuer); err != nil {
+			return errors.Wrap(err, "invalid issuer")
+		}
+	default:
+		return errors.New("config is required")
+	}
+	return nil
+}
+
+func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
+	result := &v1pb.IdentityProvider{
+		Id:            identityProvider.Id,
+		Title:         identityProvider.Title,
+		AutoProvision: identityProvider.AutoProvision,
+		SyncProfile:   identityProvider.SyncProfile,
+	}
+	if roleMapping := identityProvider.RoleMapping; roleMapping != nil {
+		result.RoleMapping = &v1pb.IdentityProviderRoleMapping{
+			Claim:       roleMapping.Claim,
+			AdminValues: roleMapping.AdminValues,
+		}
+	}
+	if config := identityProvider.GetOidc(); config != nil {
+		result.Config = &v1pb.IdentityProvider_Oidc{
+			Oidc: &v1pb.OIDCConfig{
+				Issuer:       config.Issuer,
+				ClientId:     config.ClientId,
+				ClientSecret: config.ClientSecret,
+				Scopes:       config.Scopes,
+			},
+		}
+	}
+	return result
+}
+
+func convertIdentityProviderToStore(identityProvider *v1pb.IdentityProvider) *storepb.IdentityProvider {
+	result := &storepb.IdentityProvider{
+		Id:            identityProvider.Id,
+		Title:         identityProvider.Title,
+		AutoProvision: identityProvider.AutoProvision,
+		SyncProfile:   identityProvider.SyncProfile,
+	}
+	if roleMapping := identityProvider.RoleMapping; roleMapping != nil {
+		result.RoleMapping = &storepb.IdentityProviderRoleMapping{
+			Claim:       roleMapping.Claim,
+			AdminValues: roleMapping.AdminValues,
+		}
+	}
+	if config := identityProvider.GetOidc(); config != nil {
+		result.Config = &storepb.IdentityProvider_Oidc{
+			Oidc: &storepb.OIDCConfig{
+				Issuer:       config.Issuer,
+				ClientId:     config.ClientId,
+				ClientSecret: config.ClientSecret,
+				Scopes:       config.Scopes,
+			},
+		}
+	}
+	return result
+}
+
+func convertLDAPSettingFromStore(ldapSetting *storepb.LDAPWorkspaceSetting) *v1pb.LDAPWorkspaceSetting {
+	return &v1pb.LDAPWorkspaceSetting{
+		Enabled:            ldapSetting.GetEnabled(),
+		Url:                ldapSetting.GetUrl(),
+		StartTls:           ldapSetting.GetStartTls(),
+		InsecureSkipVerify: ldapSetting.GetInsecureSkipVerify(),
+		BindDn:             ldapSetting.GetBindDn(),
+		BindPassword:       ldapSetting.GetBindPassword(),
+		BaseDn:             ldapSetting.GetBaseDn(),
+		UserFilter:         ldapSetting.GetUserFilter(),
+		EmailAttribute:     ldapSetting.GetEmailAttribute(),
+		NicknameAttribute:  ldapSetting.GetNicknameAttribute(),
+		GroupAttribute:     ldapSetting.GetGroupAttribute(),
+		AdminGroup:         ldapSetting.GetAdminGroup(),
+		AllowedGroup:       ldapSetting.GetAllowedGroup(),
+	}
+}
+
+func convertLDAPSettingToStore(ldapSetting *v1pb.LDAPWorkspaceSetting) *storepb.LDAPWorkspaceSetting {
+	return &storepb.LDAPWorkspaceSetting{
+		Enabled:            ldapSetting.GetEnabled(),
+		Url:                ldapSetting.GetUrl(),
+		StartTls:           ldapSetting.GetStartTls(),
+		InsecureSkipVerify: ldapSetting.GetInsecureSkipVerify(),
+		BindDn:             ldapSetting.GetBindDn(),
+		BindPassword:       ldapSetting.GetBindPassword(),
+		BaseDn:             ldapSetting.GetBaseDn(),
+		UserFilter:         ldapSetting.GetUserFilter(),
+		EmailAttribute:     ldapSetting.GetEmailAttribute(),
+		NicknameAttribute:  ldapSetting.GetNicknameAttribute(),
+		GroupAttribute:     ldapSetting.GetGroupAttribute(),
+		AdminGroup:         ldapSetting.GetAdminGroup(),
+		AllowedGroup:       ldapSetting.GetAllowedGroup(),
+	}
+}
+
+var (
+	ownerCacheMutex sync.Mutex
+	ownerCache      *v1pb.User
+)
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
+	ownerCacheMutex.Lock()
+	defer ownerCacheMutex.Unlock()
 	if ownerCache != nil {
 		return ownerCache, nil
 	}
@@ -199,3 +447,10 @@ func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error)
 	ownerCache = convertUserFromStore(user)
 	return ownerCache, nil
 }
+
+// resetInstanceOwnerCache clears the cached owner, e.g. once the data is restored from a backup.
+func resetInstanceOwnerCache() {
+	ownerCacheMutex.Lock()
+	defer ownerCacheMutex.Unlock()
+	ownerCache = nil
+}
@@ -5,17 +5,21 @@ import (
 	"embed"
 	"fmt"
 	"io/fs"
+	"log/slog"
 	"net/http"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/yourselfhosted/slash/internal/linktemplate"
 	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/server/profile"
+	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -29,12 +33,15 @@ const (
 type FrontendService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+
+	authInterceptor *apiv1.GRPCAuthInterceptor
 }
 
-func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendService {
+func NewFrontendService(profile *profile.Profile, store *store.Store, secret string) *FrontendService {
 	return &FrontendService{
-		Profile: profile,
-		Store:   store,
+		Profile:         profile,
+		Store:           store,
+		authInterceptor: apiv1.NewGRPCAuthInterceptor(store, secret),
 	}
 }
 
@@ -106,7 +113,7 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) error {
 func (s *FrontendService) registerRoutes(e *echo.Echo, shortcutPath string) {
 	rawIndexHTML := getRawIndexHTML()
 
-	e.GET(shortcutPath, func(c echo.Context) error {
+	shortcutHandler := func(c echo.Context) error {
 		ctx := c.Request().Context()
 		shortcutName := c.Param("shortcutName")
 		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
@@ -115,15 +122,40 @@ func (s *FrontendService) registerRoutes(e *echo.Echo, shortcutPath string) {
 		if err != nil {
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		// The SPA offers the suggestions of similar shortcuts and creating the missing one.
 		if shortcut == nil {
+			return c.HTML(http.StatusNotFound, rawIndexHTML)
+		}
+		// Fallback to the SPA if the visitor is not allowed to see the shortcut,
+		// so that it can ask the user to sign in.
+		if visible, err := apiv1.IsShortcutVisible(ctx, s.Store, shortcut, s.getCurrentUserID(c)); err != nil || !visible {
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		if now := time.Now(); !apiv1.IsShortcutActive(shortcut, now) {
+			if shortcut.FallbackLink != "" {
+				return c.Redirect(http.StatusFound, shortcut.FallbackLink)
+			}
+			if shortcut.StartTs != 0 && now.Unix() < shortcut.StartTs {
+				return c.String(http.StatusNotFound, "This shortcut is not active yet.")
+			}
+			return c.String(http.StatusGone, "This shortcut has expired.")
+		}
 
+		if err := apiv1.CreateShortcutViewActivity(ctx, s.Store, shortcut, c.RealIP(), c.Request().Referer(), c.Request().UserAgent()); err != nil {
+			slog.Error("failed to create shortcut view activity", slog.Any("error", err))
+		}
 		metric.Enqueue("shortcut view")
-		// Inject shortcut metadata into `index.html`.
-		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateShortcutMetadata(shortcut).String())
-		return c.HTML(http.StatusOK, indexHTML)
-	})
+		// Path segments following the shortcut name and query parameters fill in the link template.
+		segments := []string{}
+		for _, segment := range strings.Split(c.Param("*"), "/") {
+			if segment != "" {
+				segments = append(segments, segment)
+			}
+		}
+		return c.Redirect(http.StatusFound, linktemplate.Render(shortcut.Link, segments, c.QueryParams()))
+	}
+	e.GET(shortcutPath, shortcutHandler)
+	e.GET(path.Join(shortcutPath, "*"), shortcutHandler)
 
 	e.GET("/c/:collectionName", func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -145,6 +177,25 @@ func (s *FrontendService) registerRoutes(e *echo.Echo, shortcutPath string) {
 	})
 }
 
+// getCurrentUserID returns the id of the signed in user from the request, or nil for anonymous visitors.
+func (s *FrontendService) getCurrentUserID(c echo.Context) *int32 {
+	accessToken := ""
+	if cookie, err := c.Cookie(apiv1.AccessTokenCookieName); err == nil {
+		accessToken = cookie.Value
+	}
+	if authHeaderParts := strings.Fields(c.Request().Header.Get(echo.HeaderAuthorization)); len(authHeaderParts) == 2 && strings.ToLower(authHeaderParts[0]) == "bearer" {
+		accessToken = authHeaderParts[1]
+	}
+	if accessToken == "" {
+		return nil
+	}
+	userID, err := s.authInterceptor.Authenticate(c.Request().Context(), accessToken)
+	if err != nil {
+		return nil
+	}
+	return &userID
+}
+
 func (s *FrontendService) registerFileRoutes(ctx context.Context, e *echo.Echo, shortcutPrefix string) {
 	instanceURLSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INSTANCE_URL,
@@ -202,23 +253,6 @@ func getFileSystem(path string) http.FileSystem {
 	return http.FS(fs)
 }
 
-func generateShortcutMetadata(shortcut *storepb.Shortcut) *Metadata {
-	metadata := getDefaultMetadata()
-	title, description := shortcut.Title, shortcut.Description
-	if shortcut.OgMetadata != nil {
-		if shortcut.OgMetadata.Title != "" {
-			title = shortcut.OgMetadata.Title
-		}
-		if shortcut.OgMetadata.Description != "" {
-			description = shortcut.OgMetadata.Description
-		}
-		metadata.ImageURL = shortcut.OgMetadata.Image
-	}
-	metadata.Title = title
-	metadata.Description = description
-	return metadata
-}
-
 func generateCollectionMetadata(collection *storepb.Collection) *Metadata {
 	metadata := getDefaultMetadata()
 	metadata.Title = collection.Title
@@ -0,0 +1,150 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+const testingSecret = "secret"
+
+func TestShortcutRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	e := echo.New()
+	require.NoError(t, NewFrontendService(test.GetTestingProfile(t), ts, testingSecret).Serve(ctx, e))
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "jane@example.com",
+		Nickname: "jane",
+	})
+	require.NoError(t, err)
+	accessToken := createTestingAccessToken(ctx, t, ts, user)
+	now := time.Now()
+	for _, shortcut := range []*storepb.Shortcut{
+		{Name: "gh", Link: "https://github.com/{1}?tab={tab}", Visibility: storepb.Visibility_PUBLIC},
+		{Name: "private", Link: "https://private.example.com", Visibility: storepb.Visibility_PRIVATE},
+		{Name: "expired", Link: "https://expired.example.com", Visibility: storepb.Visibility_PUBLIC, ExpireTs: now.Add(-time.Hour).Unix()},
+		{Name: "upcoming", Link: "https://upcoming.example.com", Visibility: storepb.Visibility_PUBLIC, StartTs: now.Add(time.Hour).Unix()},
+		{Name: "moved", Link: "https://moved.example.com", Visibility: storepb.Visibility_PUBLIC, ExpireTs: now.Add(-time.Hour).Unix(), FallbackLink: "https://fallback.example.com"},
+	} {
+		shortcut.CreatorId = user.ID
+		shortcut.OgMetadata = &storepb.OpenGraphMetadata{}
+		_, err := ts.CreateShortcut(ctx, shortcut)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name         string
+		target       string
+		accessToken  string
+		userAgent    string
+		wantCode     int
+		wantLocation string
+		wantSPA      bool
+	}{
+		{
+			name:         "link template",
+			target:       "/s/gh/yourselfhosted?tab=repositories",
+			wantCode:     http.StatusFound,
+			wantLocation: "https://github.com/yourselfhosted?tab=repositories",
+		},
+		{
+			name:         "chat unfurler",
+			target:       "/s/gh/slash",
+			userAgent:    "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+			wantCode:     http.StatusFound,
+			wantLocation: "https://github.com/slash?tab=",
+		},
+		{
+			name:     "unknown name",
+			target:   "/s/unknown",
+			wantCode: http.StatusNotFound,
+			wantSPA:  true,
+		},
+		{
+			name:     "private without cookie",
+			target:   "/s/private",
+			wantCode: http.StatusOK,
+			wantSPA:  true,
+		},
+		{
+			name:         "private with cookie",
+			target:       "/s/private",
+			accessToken:  accessToken,
+			wantCode:     http.StatusFound,
+			wantLocation: "https://private.example.com",
+		},
+		{
+			name:     "expired",
+			target:   "/s/expired",
+			wantCode: http.StatusGone,
+		},
+		{
+			name:     "not active yet",
+			target:   "/s/upcoming",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:         "fallback link",
+			target:       "/s/moved",
+			wantCode:     http.StatusFound,
+			wantLocation: "https://fallback.example.com",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, test.target, nil)
+			if test.userAgent != "" {
+				request.Header.Set("User-Agent", test.userAgent)
+			}
+			if test.accessToken != "" {
+				request.AddCookie(&http.Cookie{Name: apiv1.AccessTokenCookieName, Value: test.accessToken})
+			}
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, request)
+			require.Equal(t, test.wantCode, recorder.Code)
+			require.Equal(t, test.wantLocation, recorder.Header().Get(echo.HeaderLocation))
+			if test.wantSPA {
+				require.Equal(t, getRawIndexHTML(), recorder.Body.String())
+			}
+		})
+	}
+
+	// The redirects to the links record the views, and the other responses do not.
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(activities))
+}
+
+// createTestingAccessToken returns a valid access token of the user.
+func createTestingAccessToken(ctx context.Context, t *testing.T, ts *store.Store, user *store.User) string {
+	accessToken, err := apiv1.GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte(testingSecret))
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{
+					{AccessToken: accessToken},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return accessToken
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/yourselfhosted/slash/internal/cron"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/server/profile"
 	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
 	"github.com/yourselfhosted/slash/server/route/frontend"
+	"github.com/yourselfhosted/slash/server/service/backup"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/server/service/resource"
 	"github.com/yourselfhosted/slash/store"
@@ -30,6 +32,8 @@ type Server struct {
 	Secret  string
 
 	licenseService *license.LicenseService
+	backupService  *backup.BackupService
+	cron           *cron.Cron
 
 	// API services.
 	apiV1Service *apiv1.APIV1Service
@@ -42,18 +46,16 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	e.HidePort = true
 
 	licenseService := license.NewLicenseService(profile, store)
+	cronScheduler := cron.New()
+	backupService := backup.NewBackupService(profile, store, cronScheduler)
 
 	s := &Server{
 		e:              e,
 		Profile:        profile,
 		Store:          store,
 		licenseService: licenseService,
-	}
-
-	// Serve frontend.
-	frontendService := frontend.NewFrontendService(profile, store)
-	if err := frontendService.Serve(ctx, e); err != nil {
-		return nil, errors.Wrap(err, "failed to initialize HTTP serving")
+		backupService:  backupService,
+		cron:           cronScheduler,
 	}
 
 	// In dev mode, we'd like to set the const secret key to make signin session persistence.
@@ -67,13 +69,19 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
+	// Serve frontend.
+	frontendService := frontend.NewFrontendService(profile, store, secret)
+	if err := frontendService.Serve(ctx, e); err != nil {
+		return nil, errors.Wrap(err, "failed to initialize HTTP serving")
+	}
+
 	// Register healthz endpoint.
 	e.GET("/healthz", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Service ready.")
 	})
 
 	rootGroup := e.Group("")
-	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.Profile.Port+1)
+	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, backupService, s.Profile.Port+1)
 	// Register gRPC gateway as api v1.
 	if err := s.apiV1Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -83,6 +91,8 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	resourceService := resource.NewResourceService(profile, store)
 	resourceService.Register(rootGroup)
 
+	s.registerCronJobs(ctx)
+
 	return s, nil
 }
 
@@ -102,6 +112,9 @@ func (s *Server) Start(ctx context.Context) error {
 		}
 	}()
 
+	// Start cron jobs.
+	s.cron.Start()
+
 	metric.Enqueue("server start")
 	return s.e.Start(fmt.Sprintf(":%d", s.Profile.Port))
 }
@@ -110,6 +123,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	// Stop cron jobs.
+	s.cron.Stop()
+
 	// Shutdown echo server.
 	if err := s.e.Shutdown(ctx); err != nil {
 		fmt.Printf("failed to shutdown server, error: %v\n", err)
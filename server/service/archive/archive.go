@@ -0,0 +1,385 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// Version is the current version of the archive format.
+// Archives with a newer version cannot be imported.
+const Version = 1
+
+// fileTimeLayout is the layout of the creation time in the file names of archives.
+const fileTimeLayout = "20060102_150405"
+
+// ExportOptions are the options of an export.
+type ExportOptions struct {
+	// IncludeSecrets includes the password hashes of users, the license key and the secrets of the identity providers.
+	IncludeSecrets bool
+	// IncludeActivities includes the activities.
+	IncludeActivities bool
+}
+
+type ArchiveService struct {
+	Profile *profile.Profile
+	Store   *store.Store
+}
+
+func NewArchiveService(profile *profile.Profile, store *store.Store) *ArchiveService {
+	return &ArchiveService{
+		Profile: profile,
+		Store:   store,
+	}
+}
+
+// Export exports the workspace to an archive.
+// The secret session key is never exported, the importing workspace keeps its own.
+func (s *ArchiveService) Export(ctx context.Context, options *ExportOptions) (*storepb.WorkspaceArchive, error) {
+	archive := &storepb.WorkspaceArchive{
+		Version:      Version,
+		SlashVersion: s.Profile.Version,
+		CreatedTs:    time.Now().Unix(),
+	}
+
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	for _, user := range users {
+		archivedUser := &storepb.ArchivedUser{
+			Id:        user.ID,
+			CreatedTs: user.CreatedTs,
+			UpdatedTs: user.UpdatedTs,
+			RowStatus: store.ConvertRowStatusStringToStorepb(user.RowStatus.String()),
+			Email:     user.Email,
+			Nickname:  user.Nickname,
+			Role:      string(user.Role),
+		}
+		if options.IncludeSecrets {
+			archivedUser.PasswordHash = user.PasswordHash
+		}
+		archive.Users = append(archive.Users, archivedUser)
+	}
+	slices.SortFunc(archive.Users, func(a, b *storepb.ArchivedUser) int {
+		return int(a.Id - b.Id)
+	})
+
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list groups")
+	}
+	for _, group := range groups {
+		members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{GroupID: &group.ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list group members")
+		}
+		archivedGroup := &storepb.ArchivedGroup{
+			Id:          group.ID,
+			CreatedTs:   group.CreatedTs,
+			UpdatedTs:   group.UpdatedTs,
+			Name:        group.Name,
+			Description: group.Description,
+		}
+		for _, member := range members {
+			archivedGroup.MemberIds = append(archivedGroup.MemberIds, member.UserID)
+		}
+		archive.Groups = append(archive.Groups, archivedGroup)
+	}
+	slices.SortFunc(archive.Groups, func(a, b *storepb.ArchivedGroup) int {
+		return int(a.Id - b.Id)
+	})
+
+	workspaceSettings, err := s.Store.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list workspace settings")
+	}
+	for _, workspaceSetting := range workspaceSettings {
+		if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION {
+			continue
+		}
+		if !options.IncludeSecrets {
+			workspaceSetting = removeWorkspaceSettingSecrets(workspaceSetting)
+			if workspaceSetting == nil {
+				continue
+			}
+		}
+		archive.WorkspaceSettings = append(archive.WorkspaceSettings, workspaceSetting)
+	}
+
+	userIdentities, err := s.Store.ListUserIdentities(ctx, &store.FindUserIdentity{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user identities")
+	}
+	for _, userIdentity := range userIdentities {
+		archive.UserIdentities = append(archive.UserIdentities, &storepb.ArchivedUserIdentity{
+			UserId:     userIdentity.UserID,
+			ProviderId: userIdentity.ProviderID,
+			Subject:    userIdentity.Subject,
+			CreatedTs:  userIdentity.CreatedTs,
+		})
+	}
+	slices.SortFunc(archive.UserIdentities, func(a, b *storepb.ArchivedUserIdentity) int {
+		if a.UserId != b.UserId {
+			return int(a.UserId - b.UserId)
+		}
+		return strings.Compare(a.ProviderId, b.ProviderId)
+	})
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{IncludeDeleted: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list shortcuts")
+	}
+	slices.SortFunc(shortcuts, func(a, b *storepb.Shortcut) int {
+		return int(a.Id - b.Id)
+	})
+	archive.Shortcuts = shortcuts
+
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{IncludeDeleted: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list collections")
+	}
+	slices.SortFunc(collections, func(a, b *storepb.Collection) int {
+		return int(a.Id - b.Id)
+	})
+	archive.Collections = collections
+
+	for _, resourceType := range []store.CollaboratorResourceType{store.CollaboratorResourceTypeShortcut, store.CollaboratorResourceTypeCollection} {
+		collaborators, err := s.Store.ListCollaborators(ctx, &store.FindCollaborator{ResourceType: resourceType})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list collaborators")
+		}
+		for _, collaborator := range collaborators {
+			archive.Collaborators = append(archive.Collaborators, &storepb.ArchivedCollaborator{
+				ResourceType: string(collaborator.ResourceType),
+				ResourceId:   collaborator.ResourceID,
+				UserId:       collaborator.UserID,
+				Role:         collaborator.Role.String(),
+				CreatedTs:    collaborator.CreatedTs,
+			})
+		}
+	}
+	slices.SortFunc(archive.Collaborators, func(a, b *storepb.ArchivedCollaborator) int {
+		if a.ResourceType != b.ResourceType {
+			return strings.Compare(a.ResourceType, b.ResourceType)
+		}
+		if a.ResourceId != b.ResourceId {
+			return int(a.ResourceId - b.ResourceId)
+		}
+		return int(a.UserId - b.UserId)
+	})
+
+	if options.IncludeActivities {
+		activities, err := s.Store.ListActivities(ctx, &store.FindActivity{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list activities")
+		}
+		for _, activity := range activities {
+			archive.Activities = append(archive.Activities, &storepb.ArchivedActivity{
+				Id:        activity.ID,
+				CreatorId: activity.CreatorID,
+				CreatedTs: activity.CreatedTs,
+				Type:      activity.Type.String(),
+				Level:     activity.Level.String(),
+				Payload:   activity.Payload,
+			})
+		}
+		slices.SortFunc(archive.Activities, func(a, b *storepb.ArchivedActivity) int {
+			return int(a.Id - b.Id)
+		})
+	}
+	return archive, nil
+}
+
+// removeWorkspaceSettingSecrets returns a copy of the workspace setting without its secrets,
+// or nil if the whole setting is a secret.
+func removeWorkspaceSettingSecrets(workspaceSetting *storepb.WorkspaceSetting) *storepb.WorkspaceSetting {
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY {
+		return nil
+	}
+	// The secrets are removed from a copy, as the workspace settings may be cached by the store.
+	copied := &storepb.WorkspaceSetting{}
+	proto.Merge(copied, workspaceSetting)
+	workspaceSetting = copied
+	switch workspaceSetting.Key {
+	case storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS:
+		for _, identityProvider := range workspaceSetting.GetIdentityProviders().GetIdentityProviders() {
+			if oidcConfig := identityProvider.GetOidc(); oidcConfig != nil {
+				oidcConfig.ClientSecret = ""
+			}
+		}
+	case storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP:
+		if ldapSetting := workspaceSetting.GetLdap(); ldapSetting != nil {
+			ldapSetting.BindPassword = ""
+		}
+	}
+	return workspaceSetting
+}
+
+// Import recreates the workspace of the archive with the same ids.
+// The workspace must not have any user yet, e.g. a new instance.
+// The database is restored to a snapshot taken before importing when the import fails,
+// so that a failed import can be retried.
+func (s *ArchiveService) Import(ctx context.Context, archive *storepb.WorkspaceArchive) error {
+	if archive.Version > Version {
+		return errors.Errorf("archive version %d is newer than the supported version %d", archive.Version, Version)
+	}
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+	if len(users) > 0 {
+		return errors.New("the workspace is not empty, archives can only be imported into a workspace without users")
+	}
+
+	snapshotDir, err := os.MkdirTemp("", "slash_import_")
+	if err != nil {
+		return errors.Wrap(err, "failed to create snapshot directory")
+	}
+	defer os.RemoveAll(snapshotDir)
+	snapshotPath := filepath.Join(snapshotDir, "snapshot")
+	if err := s.Store.Backup(ctx, snapshotPath); err != nil {
+		return errors.Wrap(err, "failed to take snapshot before importing")
+	}
+	if err := s.importArchive(ctx, archive); err != nil {
+		if restoreErr := s.Store.Restore(ctx, snapshotPath); restoreErr != nil {
+			return errors.Wrapf(err, "failed to restore snapshot after the import failed: %v", restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *ArchiveService) importArchive(ctx context.Context, archive *storepb.WorkspaceArchive) error {
+	// Users first, as their identities and groups reference them and shortcuts and collections their creators,
+	// then groups before the shortcuts and collections shared with them,
+	// shortcuts before the collections which reference them, and both before their collaborators.
+	for _, user := range archive.Users {
+		rowStatus := store.Normal
+		if user.RowStatus == storepb.RowStatus_ARCHIVED {
+			rowStatus = store.Archived
+		}
+		if _, err := s.Store.CreateUser(ctx, &store.User{
+			ID:           user.Id,
+			CreatedTs:    user.CreatedTs,
+			UpdatedTs:    user.UpdatedTs,
+			RowStatus:    rowStatus,
+			Email:        user.Email,
+			Nickname:     user.Nickname,
+			PasswordHash: user.PasswordHash,
+			Role:         store.Role(user.Role),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create user %d", user.Id)
+		}
+	}
+	for _, userIdentity := range archive.UserIdentities {
+		if _, err := s.Store.CreateUserIdentity(ctx, &store.UserIdentity{
+			UserID:     userIdentity.UserId,
+			ProviderID: userIdentity.ProviderId,
+			Subject:    userIdentity.Subject,
+			CreatedTs:  userIdentity.CreatedTs,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create identity of user %d", userIdentity.UserId)
+		}
+	}
+	for _, group := range archive.Groups {
+		if _, err := s.Store.CreateGroup(ctx, &store.Group{
+			ID:          group.Id,
+			CreatedTs:   group.CreatedTs,
+			UpdatedTs:   group.UpdatedTs,
+			Name:        group.Name,
+			Description: group.Description,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create group %d", group.Id)
+		}
+		for _, memberID := range group.MemberIds {
+			if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+				GroupID: group.Id,
+				UserID:  memberID,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to add member %d to group %d", memberID, group.Id)
+			}
+		}
+	}
+	for _, workspaceSetting := range archive.WorkspaceSettings {
+		if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION {
+			continue
+		}
+		if _, err := s.Store.UpsertWorkspaceSetting(ctx, workspaceSetting); err != nil {
+			return errors.Wrapf(err, "failed to upsert workspace setting %s", workspaceSetting.Key)
+		}
+	}
+	for _, shortcut := range archive.Shortcuts {
+		if shortcut.OgMetadata == nil {
+			shortcut.OgMetadata = &storepb.OpenGraphMetadata{}
+		}
+		if _, err := s.Store.CreateShortcut(ctx, shortcut); err != nil {
+			return errors.Wrapf(err, "failed to create shortcut %d", shortcut.Id)
+		}
+	}
+	for _, collection := range archive.Collections {
+		if _, err := s.Store.CreateCollection(ctx, collection); err != nil {
+			return errors.Wrapf(err, "failed to create collection %d", collection.Id)
+		}
+	}
+	for _, collaborator := range archive.Collaborators {
+		if _, err := s.Store.UpsertCollaborator(ctx, &store.Collaborator{
+			ResourceType: store.CollaboratorResourceType(collaborator.ResourceType),
+			ResourceID:   collaborator.ResourceId,
+			UserID:       collaborator.UserId,
+			Role:         store.CollaboratorRole(collaborator.Role),
+			CreatedTs:    collaborator.CreatedTs,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add collaborator %d to %s %d", collaborator.UserId, strings.ToLower(collaborator.ResourceType), collaborator.ResourceId)
+		}
+	}
+	for _, activity := range archive.Activities {
+		if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+			ID:        activity.Id,
+			CreatorID: activity.CreatorId,
+			CreatedTs: activity.CreatedTs,
+			Type:      store.ActivityType(activity.Type),
+			Level:     store.ActivityLevel(activity.Level),
+			Payload:   activity.Payload,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create activity %d", activity.Id)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes the archive as JSON.
+func Marshal(archive *storepb.WorkspaceArchive) ([]byte, error) {
+	return protojson.MarshalOptions{
+		Multiline:       true,
+		EmitUnpopulated: true,
+	}.Marshal(archive)
+}
+
+// Unmarshal decodes the archive from JSON.
+func Unmarshal(content []byte) (*storepb.WorkspaceArchive, error) {
+	archive := &storepb.WorkspaceArchive{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(content, archive); err != nil {
+		return nil, errors.Wrap(err, "failed to parse archive")
+	}
+	if archive.Version == 0 {
+		return nil, errors.New("not a workspace archive")
+	}
+	return archive, nil
+}
+
+// GetFileName returns the file name of the archive.
+func GetFileName(archive *storepb.WorkspaceArchive) string {
+	return "slash_" + time.Unix(archive.CreatedTs, 0).UTC().Format(fileTimeLayout) + ".json"
+}
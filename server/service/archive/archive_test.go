@@ -0,0 +1,227 @@
+package archive_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/archive"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func TestArchiveService(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	admin, err := ts.CreateUser(ctx, &store.User{
+		Role:         store.RoleAdmin,
+		Email:        "admin@example.com",
+		Nickname:     "admin",
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@example.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	// Deleted rows leave gaps in the ids, which are kept by the import.
+	deleted, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "deleted",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: deleted.Id}))
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "gh",
+		Link:       "https://github.com",
+		Tags:       []string{"code"},
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{Title: "GitHub"},
+		Aliases:    []string{"github"},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		UserID:       admin.ID,
+		Role:         store.CollaboratorRoleEditor,
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name: "dev",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "dev",
+		Title:       "Dev",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_GROUP,
+		GroupIds:    []int32{group.ID},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
+		Value: &storepb.WorkspaceSetting_SecretSession{SecretSession: "secret"},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INSTANCE_URL,
+		Value: &storepb.WorkspaceSetting_InstanceUrl{InstanceUrl: "https://slash.example.com"},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY,
+		Value: &storepb.WorkspaceSetting_LicenseKey{LicenseKey: "license"},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP,
+		Value: &storepb.WorkspaceSetting_Ldap{Ldap: &storepb.LDAPWorkspaceSetting{
+			Url:          "ldap://ldap.example.com",
+			BindDn:       "cn=admin,dc=example,dc=com",
+			BindPassword: "password",
+			BaseDn:       "dc=example,dc=com",
+		}},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS,
+		Value: &storepb.WorkspaceSetting_IdentityProviders{IdentityProviders: &storepb.IdentityProvidersWorkspaceSetting{
+			IdentityProviders: []*storepb.IdentityProvider{
+				{
+					Id:    "sso",
+					Title: "SSO",
+					Config: &storepb.IdentityProvider_Oidc{Oidc: &storepb.OIDCConfig{
+						Issuer:       "https://sso.example.com",
+						ClientId:     "slash",
+						ClientSecret: "secret",
+					}},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateUserIdentity(ctx, &store.UserIdentity{
+		UserID:     user.ID,
+		ProviderID: "sso",
+		Subject:    "user",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   "{}",
+	})
+	require.NoError(t, err)
+
+	workspaceArchive, err := archive.NewArchiveService(profile, ts).Export(ctx, &archive.ExportOptions{
+		IncludeActivities: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(archive.Version), workspaceArchive.Version)
+	require.Equal(t, 2, len(workspaceArchive.Users))
+	require.Equal(t, "", workspaceArchive.Users[0].PasswordHash)
+	require.Equal(t, 1, len(workspaceArchive.Shortcuts))
+	require.Equal(t, 1, len(workspaceArchive.Collections))
+	require.Equal(t, 1, len(workspaceArchive.Groups))
+	require.Equal(t, []int32{user.ID}, workspaceArchive.Groups[0].MemberIds)
+	require.Equal(t, 1, len(workspaceArchive.Collaborators))
+	require.Equal(t, "EDITOR", workspaceArchive.Collaborators[0].Role)
+	require.Equal(t, 1, len(workspaceArchive.Activities))
+	require.Equal(t, []*storepb.ArchivedUserIdentity{{UserId: user.ID, ProviderId: "sso", Subject: "user", CreatedTs: workspaceArchive.UserIdentities[0].CreatedTs}}, workspaceArchive.UserIdentities)
+	// The secrets are not exported unless requested.
+	for _, workspaceSetting := range workspaceArchive.WorkspaceSettings {
+		require.NotEqual(t, storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION, workspaceSetting.Key)
+		require.NotEqual(t, storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY, workspaceSetting.Key)
+		require.Equal(t, "", workspaceSetting.GetLdap().GetBindPassword())
+		for _, identityProvider := range workspaceSetting.GetIdentityProviders().GetIdentityProviders() {
+			require.Equal(t, "slash", identityProvider.GetOidc().GetClientId())
+			require.Equal(t, "", identityProvider.GetOidc().GetClientSecret())
+		}
+	}
+	ldapSetting, err := ts.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP})
+	require.NoError(t, err)
+	require.Equal(t, "password", ldapSetting.GetLdap().BindPassword)
+	secretArchive, err := archive.NewArchiveService(profile, ts).Export(ctx, &archive.ExportOptions{
+		IncludeSecrets: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "hash", secretArchive.Users[0].PasswordHash)
+	secrets := []string{}
+	for _, workspaceSetting := range secretArchive.WorkspaceSettings {
+		require.NotEqual(t, storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION, workspaceSetting.Key)
+		switch workspaceSetting.Key {
+		case storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY:
+			secrets = append(secrets, workspaceSetting.GetLicenseKey())
+		case storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP:
+			secrets = append(secrets, workspaceSetting.GetLdap().BindPassword)
+		case storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS:
+			secrets = append(secrets, workspaceSetting.GetIdentityProviders().IdentityProviders[0].GetOidc().ClientSecret)
+		}
+	}
+	require.ElementsMatch(t, []string{"license", "password", "secret"}, secrets)
+
+	content, err := archive.Marshal(workspaceArchive)
+	require.NoError(t, err)
+
+	// The archive can be imported into a new workspace only.
+	parsedArchive, err := archive.Unmarshal(content)
+	require.NoError(t, err)
+	err = archive.NewArchiveService(profile, ts).Import(ctx, parsedArchive)
+	require.Error(t, err)
+	newStore := teststore.NewTestingStore(ctx, t)
+	newArchiveService := archive.NewArchiveService(profile, newStore)
+
+	// A failed import leaves the workspace empty, so that it can be retried.
+	invalidArchive := &storepb.WorkspaceArchive{}
+	proto.Merge(invalidArchive, parsedArchive)
+	invalidArchive.Users = append(invalidArchive.Users, invalidArchive.Users[0])
+	err = newArchiveService.Import(ctx, invalidArchive)
+	require.Error(t, err)
+	users, err := newStore.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+	err = newArchiveService.Import(ctx, parsedArchive)
+	require.NoError(t, err)
+
+	importedArchive, err := newArchiveService.Export(ctx, &archive.ExportOptions{
+		IncludeActivities: true,
+	})
+	require.NoError(t, err)
+	importedArchive.CreatedTs = workspaceArchive.CreatedTs
+	require.True(t, proto.Equal(workspaceArchive, importedArchive), "got %v, want %v", importedArchive, workspaceArchive)
+
+	// New rows get new ids.
+	created, err := newStore.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "new",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Greater(t, created.Id, shortcut.Id)
+
+	_, err = archive.Unmarshal([]byte(`{"users": []}`))
+	require.Error(t, err)
+	err = newArchiveService.Import(ctx, &storepb.WorkspaceArchive{Version: archive.Version + 1})
+	require.Error(t, err)
+}
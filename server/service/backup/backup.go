@@ -0,0 +1,278 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/cron"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// backupDirName is the directory of backups in the data directory.
+	backupDirName = "backups"
+	// backupFilePrefix is the prefix of the file names of backups.
+	backupFilePrefix = "slash_"
+	// backupTimeLayout is the layout of the creation time in the file names of backups.
+	backupTimeLayout = "20060102_150405"
+	// autoBackupJobID is the id of the auto backup job in the cron scheduler.
+	autoBackupJobID = "auto-backup"
+)
+
+// Backup is a snapshot file in the backup directory.
+type Backup struct {
+	Name        string
+	Size        int64
+	CreatedTime time.Time
+}
+
+type BackupService struct {
+	Profile *profile.Profile
+	Store   *store.Store
+
+	cron *cron.Cron
+	// mutex prevents the concurrent creating and pruning of backups.
+	mutex sync.Mutex
+}
+
+func NewBackupService(profile *profile.Profile, store *store.Store, cron *cron.Cron) *BackupService {
+	return &BackupService{
+		Profile: profile,
+		Store:   store,
+		cron:    cron,
+	}
+}
+
+// RegisterAutoBackup registers the auto backup job from the workspace setting,
+// replacing the previously registered one. The job is removed if auto backup is disabled.
+func (s *BackupService) RegisterAutoBackup(ctx context.Context) error {
+	autoBackupSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get auto backup setting")
+	}
+	autoBackup := autoBackupSetting.GetAutoBackup()
+	if autoBackup == nil || !autoBackup.Enabled {
+		s.cron.Remove(autoBackupJobID)
+		return nil
+	}
+
+	return s.cron.Add(autoBackupJobID, autoBackup.CronExpression, func() {
+		// The job outlives the context of the registration, e.g. the request updating the setting.
+		ctx := context.Background()
+		backup, err := s.CreateBackup(ctx)
+		if err != nil {
+			slog.Error("failed to create auto backup", slog.Any("error", err))
+			return
+		}
+		slog.Info("auto backup created", slog.String("name", backup.Name))
+		if err := s.PruneBackups(int(autoBackup.MaxKeep)); err != nil {
+			slog.Error("failed to prune backups", slog.Any("error", err))
+		}
+	})
+}
+
+// GetBackupDir returns the directory of backups.
+func (s *BackupService) GetBackupDir() string {
+	return filepath.Join(s.Profile.Data, backupDirName)
+}
+
+// CreateBackup writes a new snapshot of the database to the backup directory.
+func (s *BackupService) CreateBackup(ctx context.Context) (*Backup, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.Profile.Data == "" {
+		return nil, errors.New("data directory is not set")
+	}
+	backupDir := s.GetBackupDir()
+	if err := os.MkdirAll(backupDir, 0770); err != nil {
+		return nil, errors.Wrap(err, "failed to create backup directory")
+	}
+
+	createdTime := time.Now().UTC()
+	name := fmt.Sprintf("%s%s%s", backupFilePrefix, createdTime.Format(backupTimeLayout), s.getBackupFileExt())
+	// Suffix the name with a counter for the backups created within the same second.
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(backupDir, name)); os.IsNotExist(err) {
+			break
+		}
+		name = fmt.Sprintf("%s%s_%d%s", backupFilePrefix, createdTime.Format(backupTimeLayout), i, s.getBackupFileExt())
+	}
+	path := filepath.Join(backupDir, name)
+	// Write to a temporary file first, so that a failed backup never leaves a partial snapshot.
+	tempPath := path + ".tmp"
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "failed to remove temporary backup file")
+	}
+	if err := s.Store.Backup(ctx, tempPath); err != nil {
+		_ = os.Remove(tempPath)
+		return nil, errors.Wrap(err, "failed to back up database")
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return nil, errors.Wrap(err, "failed to rename backup file")
+	}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stat backup file")
+	}
+	return &Backup{
+		Name:        name,
+		Size:        fileInfo.Size(),
+		CreatedTime: createdTime,
+	}, nil
+}
+
+// ListBackups returns the backups in the backup directory, the newest first.
+func (s *BackupService) ListBackups() ([]*Backup, error) {
+	entries, err := os.ReadDir(s.GetBackupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*Backup{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to read backup directory")
+	}
+
+	backups := []*Backup{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		createdTime, ok := parseBackupFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to stat backup %s", entry.Name())
+		}
+		backups = append(backups, &Backup{
+			Name:        entry.Name(),
+			Size:        fileInfo.Size(),
+			CreatedTime: createdTime,
+		})
+	}
+	slices.SortFunc(backups, func(a, b *Backup) int {
+		if c := b.CreatedTime.Compare(a.CreatedTime); c != 0 {
+			return c
+		}
+		return compareBackupNames(b.Name, a.Name)
+	})
+	return backups, nil
+}
+
+// GetBackupPath returns the path of the backup with the name.
+// It returns an error if the name is not the name of a backup, e.g. a path outside of the backup directory.
+func (s *BackupService) GetBackupPath(name string) (string, error) {
+	if filepath.Base(name) != name {
+		return "", errors.Errorf("invalid backup name %q", name)
+	}
+	if _, ok := parseBackupFileName(name); !ok {
+		return "", errors.Errorf("invalid backup name %q", name)
+	}
+	return filepath.Join(s.GetBackupDir(), name), nil
+}
+
+// RestoreBackup replaces the data of the database with the backup.
+// A backup of the current data is created first and returned, so that restoring can be undone.
+func (s *BackupService) RestoreBackup(ctx context.Context, name string) (*Backup, error) {
+	path, err := s.GetBackupPath(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Wrapf(err, "failed to stat backup %s", name)
+	}
+	previousBackup, err := s.CreateBackup(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to back up the current data")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.Store.Restore(ctx, path); err != nil {
+		return nil, errors.Wrapf(err, "failed to restore backup %s", name)
+	}
+	// The auto backup setting may be different in the restored data.
+	if err := s.RegisterAutoBackup(ctx); err != nil {
+		slog.Error("failed to register auto backup after restoring", slog.Any("error", err))
+	}
+	return previousBackup, nil
+}
+
+// PruneBackups removes the oldest backups, keeping at most maxKeep of them.
+// All backups are kept if maxKeep is not positive.
+func (s *BackupService) PruneBackups(maxKeep int) error {
+	if maxKeep <= 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backups, err := s.ListBackups()
+	if err != nil {
+		return err
+	}
+	if len(backups) <= maxKeep {
+		return nil
+	}
+	for _, backup := range backups[maxKeep:] {
+		if err := os.Remove(filepath.Join(s.GetBackupDir(), backup.Name)); err != nil {
+			return errors.Wrapf(err, "failed to remove backup %s", backup.Name)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) getBackupFileExt() string {
+	if s.Profile.Driver == "sqlite" {
+		return ".db"
+	}
+	return ".sql"
+}
+
+// parseBackupFileName returns the creation time of the backup with the file name,
+// and false if the file is not a backup.
+func parseBackupFileName(name string) (time.Time, bool) {
+	ext := filepath.Ext(name)
+	if ext != ".db" && ext != ".sql" {
+		return time.Time{}, false
+	}
+	timeString, ok := strings.CutPrefix(strings.TrimSuffix(name, ext), backupFilePrefix)
+	if !ok || len(timeString) < len(backupTimeLayout) {
+		return time.Time{}, false
+	}
+	if counter := timeString[len(backupTimeLayout):]; counter != "" {
+		if _, err := strconv.Atoi(strings.TrimPrefix(counter, "_")); err != nil || !strings.HasPrefix(counter, "_") {
+			return time.Time{}, false
+		}
+	}
+	createdTime, err := time.ParseInLocation(backupTimeLayout, timeString[:len(backupTimeLayout)], time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return createdTime, true
+}
+
+// compareBackupNames compares the names of backups created within the same second by their counters.
+func compareBackupNames(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
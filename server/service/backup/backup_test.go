@@ -0,0 +1,96 @@
+package backup_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/internal/cron"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/backup"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func TestBackupService(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	c := cron.New()
+	backupService := backup.NewBackupService(profile, ts, c)
+
+	backups, err := backupService.ListBackups()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(backups))
+
+	// Fake two older backups, and ignore unrelated files.
+	backupDir := backupService.GetBackupDir()
+	require.NoError(t, os.MkdirAll(backupDir, 0770))
+	for _, name := range []string{"slash_20240101_000000.db", "slash_20240102_000000.sql", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(backupDir, name), []byte{}, 0600))
+	}
+	created, err := backupService.CreateBackup(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, created.Size)
+
+	backups, err = backupService.ListBackups()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(backups))
+	require.Equal(t, created.Name, backups[0].Name)
+
+	err = backupService.PruneBackups(2)
+	require.NoError(t, err)
+	backups, err = backupService.ListBackups()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(backups))
+	require.Equal(t, created.Name, backups[0].Name)
+	require.Equal(t, "slash_20240102_000000.sql", backups[1].Name)
+	_, err = os.Stat(filepath.Join(backupDir, "notes.txt"))
+	require.NoError(t, err)
+
+	// The auto backup job follows the workspace setting.
+	err = backupService.RegisterAutoBackup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, c.Total())
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP,
+		Value: &storepb.WorkspaceSetting_AutoBackup{
+			AutoBackup: &storepb.AutoBackupWorkspaceSetting{
+				Enabled:        true,
+				CronExpression: "0 0 * * *",
+				MaxKeep:        7,
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = backupService.RegisterAutoBackup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, c.Total())
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP,
+		Value: &storepb.WorkspaceSetting_AutoBackup{
+			AutoBackup: &storepb.AutoBackupWorkspaceSetting{},
+		},
+	})
+	require.NoError(t, err)
+	err = backupService.RegisterAutoBackup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, c.Total())
+
+	// Restoring a backup creates a backup of the current data first.
+	_, err = backupService.GetBackupPath("../slash_20240101_000000.db")
+	require.Error(t, err)
+	_, err = backupService.GetBackupPath("notes.txt")
+	require.Error(t, err)
+	previous, err := backupService.RestoreBackup(ctx, created.Name)
+	require.NoError(t, err)
+	require.NotEqual(t, created.Name, previous.Name)
+	backups, err = backupService.ListBackups()
+	require.NoError(t, err)
+	require.Equal(t, previous.Name, backups[0].Name)
+	_, err = backupService.RestoreBackup(ctx, "slash_20200101_000000.db")
+	require.Error(t, err)
+}
@@ -0,0 +1,289 @@
+package idp
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+)
+
+var (
+	// ErrUserNotProvisioned is returned when the user signs in for the first time
+	// while the identity provider does not create users.
+	ErrUserNotProvisioned = errors.New("user is not provisioned")
+	// ErrUserArchived is returned when the user of the identity is archived.
+	ErrUserArchived = errors.New("user is archived")
+	// ErrEmailNotVerified is returned when the identity is linked by an email which the provider does not verify.
+	ErrEmailNotVerified = errors.New("email is not verified by the identity provider")
+	// ErrEmailTaken is returned when the synced email of the identity is the email of another user.
+	ErrEmailTaken = errors.New("email is used by another user")
+)
+
+// UserInfo is the identity of a user in an identity provider.
+type UserInfo struct {
+	// Subject is the identifier of the user in the identity provider.
+	Subject string
+	Email   string
+	// EmailVerified is false when the identity provider states that the email is not verified.
+	EmailVerified bool
+	DisplayName   string
+	// Claims are all the claims or attributes of the user, used for the role mapping.
+	Claims map[string]any
+}
+
+type IdentityProviderService struct {
+	Store          *store.Store
+	LicenseService *license.LicenseService
+}
+
+func NewIdentityProviderService(store *store.Store, licenseService *license.LicenseService) *IdentityProviderService {
+	return &IdentityProviderService{
+		Store:          store,
+		LicenseService: licenseService,
+	}
+}
+
+// ListIdentityProviders returns the identity providers of the workspace setting.
+func (s *IdentityProviderService) ListIdentityProviders(ctx context.Context) ([]*storepb.IdentityProvider, error) {
+	identityProvidersSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get identity providers setting")
+	}
+	if identityProvidersSetting == nil {
+		return []*storepb.IdentityProvider{}, nil
+	}
+	return identityProvidersSetting.GetIdentityProviders().GetIdentityProviders(), nil
+}
+
+// GetIdentityProvider returns the identity provider with the id, or nil if there is none.
+func (s *IdentityProviderService) GetIdentityProvider(ctx context.Context, id string) (*storepb.IdentityProvider, error) {
+	identityProviders, err := s.ListIdentityProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, identityProvider := range identityProviders {
+		if identityProvider.Id == id {
+			return identityProvider, nil
+		}
+	}
+	return nil, nil
+}
+
+// SignIn returns the user of the identity. At the first sign-in, the identity is linked to
+// the existing user with the same verified email, or to a new user if the provider creates users.
+// The role of the user follows the role mapping of the provider at every sign-in, except for the demotion
+// of the last admin, as well as the verified email and the nickname if the provider syncs the profiles.
+func (s *IdentityProviderService) SignIn(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *UserInfo) (*store.User, error) {
+	if userInfo.Subject == "" {
+		return nil, errors.New("the identity has no subject")
+	}
+
+	user, err := s.findLinkedUser(ctx, identityProvider, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if user, err = s.linkUser(ctx, identityProvider, userInfo); err != nil {
+			return nil, err
+		}
+	}
+	if user.RowStatus == store.Archived {
+		return nil, ErrUserArchived
+	}
+
+	update := &store.UpdateUser{
+		ID: user.ID,
+	}
+	if role, ok := MapRole(identityProvider.RoleMapping, userInfo.Claims); ok && role != user.Role {
+		isLastAdmin, err := s.isLastAdmin(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		// The last admin keeps their role, e.g. a local admin linked by their email to an identity
+		// outside the admin group, so that the workspace is not left without an admin.
+		if isLastAdmin {
+			slog.Warn("the last admin is not demoted by the role mapping", slog.String("provider", identityProvider.Id), slog.Int("user", int(user.ID)))
+		} else {
+			update.Role = &role
+		}
+	}
+	if identityProvider.SyncProfile {
+		// Unverified emails are not synced, so that users cannot take the email of another account.
+		if userInfo.Email != "" && userInfo.EmailVerified && userInfo.Email != user.Email {
+			emailUser, err := s.Store.GetUser(ctx, &store.FindUser{
+				Email: &userInfo.Email,
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get user by email")
+			}
+			if emailUser != nil {
+				return nil, errors.Wrapf(ErrEmailTaken, "email %s", userInfo.Email)
+			}
+			update.Email = &userInfo.Email
+		}
+		if userInfo.DisplayName != "" && userInfo.DisplayName != user.Nickname {
+			update.Nickname = &userInfo.DisplayName
+		}
+	}
+	if update.Role != nil || update.Email != nil || update.Nickname != nil {
+		if user, err = s.Store.UpdateUser(ctx, update); err != nil {
+			return nil, errors.Wrap(err, "failed to update user")
+		}
+	}
+	return user, nil
+}
+
+func (s *IdentityProviderService) findLinkedUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *UserInfo) (*store.User, error) {
+	identity, err := s.Store.GetUserIdentity(ctx, &store.FindUserIdentity{
+		ProviderID: &identityProvider.Id,
+		Subject:    &userInfo.Subject,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user identity")
+	}
+	if identity == nil {
+		return nil, nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &identity.UserID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	return user, nil
+}
+
+// linkUser links the identity to the user with the same email, creating the user if needed.
+func (s *IdentityProviderService) linkUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *UserInfo) (*store.User, error) {
+	if userInfo.Email == "" {
+		return nil, errors.New("the identity has no email")
+	}
+	if !userInfo.EmailVerified {
+		return nil, errors.Wrapf(ErrEmailNotVerified, "email %s", userInfo.Email)
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &userInfo.Email,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user by email")
+	}
+	if user == nil {
+		if !identityProvider.AutoProvision {
+			return nil, ErrUserNotProvisioned
+		}
+		if user, err = s.createUser(ctx, identityProvider, userInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.Store.CreateUserIdentity(ctx, &store.UserIdentity{
+		UserID:     user.ID,
+		ProviderID: identityProvider.Id,
+		Subject:    userInfo.Subject,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to create user identity")
+	}
+	return user, nil
+}
+
+func (s *IdentityProviderService) createUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *UserInfo) (*store.User, error) {
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedAccounts) && len(users) >= 5 {
+		return nil, errors.New("maximum number of users reached")
+	}
+
+	create := &store.User{
+		Email:    userInfo.Email,
+		Nickname: userInfo.DisplayName,
+		Role:     store.RoleUser,
+	}
+	if create.Nickname == "" {
+		create.Nickname, _, _ = strings.Cut(userInfo.Email, "@")
+	}
+	// The first user is an admin by default, as for the sign up.
+	if len(users) == 0 {
+		create.Role = store.RoleAdmin
+	} else if role, ok := MapRole(identityProvider.RoleMapping, userInfo.Claims); ok {
+		create.Role = role
+	}
+	// The users of identity providers sign in without password, so a random one is set.
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate password hash")
+	}
+	create.PasswordHash = string(passwordHash)
+
+	user, err := s.Store.CreateUser(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+	return user, nil
+}
+
+// isLastAdmin returns whether the user is the only active admin of the workspace.
+func (s *IdentityProviderService) isLastAdmin(ctx context.Context, user *store.User) (bool, error) {
+	if user.Role != store.RoleAdmin {
+		return false, nil
+	}
+	role, rowStatus := store.RoleAdmin, store.Normal
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role:      &role,
+		RowStatus: &rowStatus,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list admins")
+	}
+	for _, admin := range admins {
+		if admin.ID != user.ID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// MapRole returns the role of the user from their claims, and false if the role mapping is not configured.
+func MapRole(roleMapping *storepb.IdentityProviderRoleMapping, claims map[string]any) (store.Role, bool) {
+	if roleMapping.GetClaim() == "" {
+		return "", false
+	}
+	for _, value := range getClaimValues(claims[roleMapping.Claim]) {
+		if slices.Contains(roleMapping.AdminValues, value) {
+			return store.RoleAdmin, true
+		}
+	}
+	return store.RoleUser, true
+}
+
+// getClaimValues returns the string values of a claim, which is either a string or a list of strings.
+func getClaimValues(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			if value, ok := item.(string); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,235 @@
+package idp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func TestSignIn(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	service := idp.NewIdentityProviderService(ts, license.NewLicenseService(profile, ts))
+	identityProvider := &storepb.IdentityProvider{
+		Id:            "corp",
+		Title:         "Corp",
+		AutoProvision: true,
+		RoleMapping: &storepb.IdentityProviderRoleMapping{
+			Claim:       "groups",
+			AdminValues: []string{"slash-admins"},
+		},
+	}
+	admin, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin@example.com",
+		Nickname: "admin",
+	})
+	require.NoError(t, err)
+
+	// The first sign-in creates the user, with the role of the claims.
+	user, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Claims:        map[string]any{"groups": []any{"staff"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", user.Email)
+	require.Equal(t, "jane", user.Nickname)
+	require.Equal(t, store.RoleUser, user.Role)
+
+	// The next sign-ins find the user by the identity, even if the email changed, and sync the role.
+	signedIn, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject-1",
+		Email:         "jane.doe@example.com",
+		EmailVerified: true,
+		Claims:        map[string]any{"groups": []any{"staff", "slash-admins"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, signedIn.ID)
+	require.Equal(t, store.RoleAdmin, signedIn.Role)
+
+	// An existing user is linked by their email.
+	signedIn, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject-2",
+		Email:         admin.Email,
+		EmailVerified: true,
+		Claims:        map[string]any{"groups": "slash-admins"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, signedIn.ID)
+	identities, err := ts.ListUserIdentities(ctx, &store.FindUserIdentity{UserID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(identities))
+	require.Equal(t, "subject-2", identities[0].Subject)
+
+	// Unverified emails are not linked.
+	_, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject: "subject-3",
+		Email:   "unverified@example.com",
+	})
+	require.ErrorIs(t, err, idp.ErrEmailNotVerified)
+
+	// Archived users cannot sign in.
+	archived := store.Archived
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:        user.ID,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	_, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+	})
+	require.ErrorIs(t, err, idp.ErrUserArchived)
+}
+
+func TestSignInWithoutAutoProvision(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	service := idp.NewIdentityProviderService(ts, license.NewLicenseService(profile, ts))
+	identityProvider := &storepb.IdentityProvider{
+		Id:    "corp",
+		Title: "Corp",
+	}
+
+	_, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject",
+		Email:         "unknown@example.com",
+		EmailVerified: true,
+	})
+	require.ErrorIs(t, err, idp.ErrUserNotProvisioned)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+}
+
+func TestSignInSyncProfile(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	service := idp.NewIdentityProviderService(ts, license.NewLicenseService(profile, ts))
+	identityProvider := &storepb.IdentityProvider{
+		Id:            "corp",
+		Title:         "Corp",
+		AutoProvision: true,
+		SyncProfile:   true,
+	}
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin@example.com",
+		Nickname: "admin",
+	})
+	require.NoError(t, err)
+	user, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+	})
+	require.NoError(t, err)
+
+	// Unverified emails are not synced.
+	signedIn, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:     "subject",
+		Email:       "jane.doe@example.com",
+		DisplayName: "Jane Doe",
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, signedIn.ID)
+	require.Equal(t, "jane@example.com", signedIn.Email)
+	require.Equal(t, "Jane Doe", signedIn.Nickname)
+
+	// Verified emails are synced.
+	signedIn, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject",
+		Email:         "jane.doe@example.com",
+		EmailVerified: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "jane.doe@example.com", signedIn.Email)
+
+	// The email of another user is not taken.
+	_, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject",
+		Email:         other.Email,
+		EmailVerified: true,
+	})
+	require.ErrorIs(t, err, idp.ErrEmailTaken)
+	user, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, "jane.doe@example.com", user.Email)
+}
+
+func TestSignInKeepsLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	service := idp.NewIdentityProviderService(ts, license.NewLicenseService(profile, ts))
+	identityProvider := &storepb.IdentityProvider{
+		Id:    "corp",
+		Title: "Corp",
+		RoleMapping: &storepb.IdentityProviderRoleMapping{
+			Claim:       "groups",
+			AdminValues: []string{"slash-admins"},
+		},
+	}
+	admin, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin@example.com",
+		Nickname: "admin",
+	})
+	require.NoError(t, err)
+
+	// The only admin linked by their email to an identity outside the admin group stays an admin.
+	signedIn, err := service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject:       "subject-1",
+		Email:         admin.Email,
+		EmailVerified: true,
+		Claims:        map[string]any{"groups": "staff"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleAdmin, signedIn.Role)
+
+	// Once there is another admin, the role mapping applies.
+	_, err = ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "other-admin@example.com",
+		Nickname: "other-admin",
+	})
+	require.NoError(t, err)
+	signedIn, err = service.SignIn(ctx, identityProvider, &idp.UserInfo{
+		Subject: "subject-1",
+		Claims:  map[string]any{"groups": "staff"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleUser, signedIn.Role)
+}
+
+func TestMapRole(t *testing.T) {
+	roleMapping := &storepb.IdentityProviderRoleMapping{
+		Claim:       "role",
+		AdminValues: []string{"admin", "owner"},
+	}
+	_, ok := idp.MapRole(nil, map[string]any{"role": "admin"})
+	require.False(t, ok)
+	role, ok := idp.MapRole(roleMapping, map[string]any{"role": "owner"})
+	require.True(t, ok)
+	require.Equal(t, store.RoleAdmin, role)
+	role, ok = idp.MapRole(roleMapping, map[string]any{"role": []string{"member"}})
+	require.True(t, ok)
+	require.Equal(t, store.RoleUser, role)
+	role, ok = idp.MapRole(roleMapping, map[string]any{})
+	require.True(t, ok)
+	require.Equal(t, store.RoleUser, role)
+}
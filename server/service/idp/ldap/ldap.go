@@ -0,0 +1,217 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp"
+)
+
+const (
+	// ProviderID is the id of the LDAP identity provider, which links the users to their directory entries.
+	ProviderID = "ldap"
+
+	defaultUserFilter        = "(|(uid={login})(mail={login}))"
+	defaultEmailAttribute    = "mail"
+	defaultNicknameAttribute = "displayName"
+	defaultGroupAttribute    = "memberOf"
+	timeout                  = 10 * time.Second
+)
+
+var (
+	// ErrUserNotFound is returned when no user of the directory matches the login.
+	ErrUserNotFound = errors.New("user not found in the directory")
+	// ErrInvalidCredentials is returned when the password does not match the user of the directory.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrUserNotAllowed is returned when the user is not a member of the allowed group.
+	ErrUserNotAllowed = errors.New("user is not a member of the allowed group")
+)
+
+// Authenticator authenticates the users with a bind of a service account, a search of the user entry,
+// and a bind of the user entry with their password.
+type Authenticator struct {
+	config *storepb.LDAPWorkspaceSetting
+}
+
+// NewAuthenticator returns the authenticator of the config, with the defaults of the unset attributes.
+func NewAuthenticator(config *storepb.LDAPWorkspaceSetting) (*Authenticator, error) {
+	serverURL, err := url.Parse(config.GetUrl())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	if serverURL.Scheme != "ldap" && serverURL.Scheme != "ldaps" {
+		return nil, errors.New("url must start with ldap:// or ldaps://")
+	}
+	if serverURL.Hostname() == "" {
+		return nil, errors.New("url has no host")
+	}
+	if _, err := goldap.ParseDN(config.GetBaseDn()); err != nil || config.GetBaseDn() == "" {
+		return nil, errors.New("invalid base dn")
+	}
+	if config.GetUserFilter() != "" {
+		if !strings.Contains(config.UserFilter, "{login}") {
+			return nil, errors.New("user filter must contain {login}")
+		}
+		if _, err := goldap.CompileFilter(strings.ReplaceAll(config.UserFilter, "{login}", "login")); err != nil {
+			return nil, errors.Wrap(err, "invalid user filter")
+		}
+	}
+	for _, group := range []string{config.GetAdminGroup(), config.GetAllowedGroup()} {
+		if _, err := goldap.ParseDN(group); err != nil {
+			return nil, errors.Wrapf(err, "invalid group dn %s", group)
+		}
+	}
+
+	// The defaults are set on a copy, to keep the stored config as is.
+	config = &storepb.LDAPWorkspaceSetting{
+		Enabled:            config.Enabled,
+		Url:                config.Url,
+		StartTls:           config.StartTls,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		BindDn:             config.BindDn,
+		BindPassword:       config.BindPassword,
+		BaseDn:             config.BaseDn,
+		UserFilter:         getOrDefault(config.UserFilter, defaultUserFilter),
+		EmailAttribute:     getOrDefault(config.EmailAttribute, defaultEmailAttribute),
+		NicknameAttribute:  getOrDefault(config.NicknameAttribute, defaultNicknameAttribute),
+		GroupAttribute:     getOrDefault(config.GroupAttribute, defaultGroupAttribute),
+		AdminGroup:         config.AdminGroup,
+		AllowedGroup:       config.AllowedGroup,
+	}
+	return &Authenticator{
+		config: config,
+	}, nil
+}
+
+// IdentityProvider returns the identity provider which signs in the authenticated users.
+// The users are created at their first sign-in, and their profiles and roles follow the directory.
+func (a *Authenticator) IdentityProvider() *storepb.IdentityProvider {
+	identityProvider := &storepb.IdentityProvider{
+		Id:            ProviderID,
+		Title:         "LDAP",
+		AutoProvision: true,
+		SyncProfile:   true,
+	}
+	if a.config.AdminGroup != "" {
+		identityProvider.RoleMapping = &storepb.IdentityProviderRoleMapping{
+			Claim:       a.config.GroupAttribute,
+			AdminValues: []string{normalizeDN(a.config.AdminGroup)},
+		}
+	}
+	return identityProvider
+}
+
+// Authenticate returns the user info of the directory entry of the login, if the password matches.
+// The groups of the user are in the claim of the group attribute, as normalized DNs.
+func (a *Authenticator) Authenticate(login, password string) (*idp.UserInfo, error) {
+	// An empty password is an unauthenticated bind, which most servers accept.
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if a.config.BindDn != "" {
+		if err := conn.Bind(a.config.BindDn, a.config.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "failed to bind the service account")
+		}
+	}
+	result, err := conn.Search(goldap.NewSearchRequest(
+		a.config.BaseDn,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		2,
+		int(timeout.Seconds()),
+		false,
+		strings.ReplaceAll(a.config.UserFilter, "{login}", goldap.EscapeFilter(login)),
+		[]string{a.config.EmailAttribute, a.config.NicknameAttribute, a.config.GroupAttribute},
+		nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrap(err, "failed to search the user")
+	}
+	if err != nil || len(result.Entries) > 1 {
+		return nil, errors.Errorf("multiple users of the directory match %s", login)
+	}
+	if len(result.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	entry := result.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "failed to bind the user")
+	}
+
+	groups := []string{}
+	for _, group := range entry.GetEqualFoldAttributeValues(a.config.GroupAttribute) {
+		groups = append(groups, normalizeDN(group))
+	}
+	if a.config.AllowedGroup != "" && !slices.Contains(groups, normalizeDN(a.config.AllowedGroup)) {
+		return nil, ErrUserNotAllowed
+	}
+	return &idp.UserInfo{
+		Subject: normalizeDN(entry.DN),
+		Email:   entry.GetEqualFoldAttributeValue(a.config.EmailAttribute),
+		// The directory is the authority of the emails of its users.
+		EmailVerified: true,
+		DisplayName:   entry.GetEqualFoldAttributeValue(a.config.NicknameAttribute),
+		Claims: map[string]any{
+			a.config.GroupAttribute: groups,
+		},
+	}, nil
+}
+
+func (a *Authenticator) dial() (*goldap.Conn, error) {
+	serverURL, err := url.Parse(a.config.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	tlsConfig := &tls.Config{
+		ServerName: serverURL.Hostname(),
+		// The verification is skipped only when the admin configures it.
+		InsecureSkipVerify: a.config.InsecureSkipVerify,
+	}
+	conn, err := goldap.DialURL(a.config.Url, goldap.DialWithDialer(&net.Dialer{Timeout: timeout}), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the server")
+	}
+	conn.SetTimeout(timeout)
+	if a.config.StartTls && serverURL.Scheme == "ldap" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start tls")
+		}
+	}
+	return conn, nil
+}
+
+// normalizeDN returns the DN in lowercase without the optional spaces, to compare DNs as strings.
+func normalizeDN(dn string) string {
+	parsed, err := goldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(dn))
+	}
+	return strings.ToLower(parsed.String())
+}
+
+func getOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,180 @@
+package ldap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap"
+	"github.com/yourselfhosted/slash/server/service/idp/ldap/ldaptest"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func newTestingServer(t *testing.T) *ldaptest.Server {
+	server, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:       "cn=slash,ou=services,dc=example,dc=com",
+			Password: "service-secret",
+		},
+		&ldaptest.Entry{
+			DN: "uid=jane,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"uid":         {"jane"},
+				"mail":        {"jane@example.com"},
+				"displayName": {"Jane Doe"},
+				"memberOf":    {"cn=slash-users,ou=groups,dc=example,dc=com", "CN=Slash-Admins, OU=Groups, DC=example, DC=com"},
+			},
+			Password: "jane-secret",
+		},
+		&ldaptest.Entry{
+			DN: "uid=john,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"uid":         {"john"},
+				"mail":        {"john@example.com"},
+				"displayName": {"John Doe"},
+			},
+			Password: "john-secret",
+		},
+	)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestingConfig(server *ldaptest.Server) *storepb.LDAPWorkspaceSetting {
+	return &storepb.LDAPWorkspaceSetting{
+		Enabled:      true,
+		Url:          server.URL(),
+		BindDn:       "cn=slash,ou=services,dc=example,dc=com",
+		BindPassword: "service-secret",
+		BaseDn:       "ou=people,dc=example,dc=com",
+		AdminGroup:   "cn=slash-admins,ou=groups,dc=example,dc=com",
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := newTestingServer(t)
+	authenticator, err := ldap.NewAuthenticator(newTestingConfig(server))
+	require.NoError(t, err)
+
+	for _, login := range []string{"jane", "jane@example.com"} {
+		userInfo, err := authenticator.Authenticate(login, "jane-secret")
+		require.NoError(t, err)
+		require.Equal(t, "uid=jane,ou=people,dc=example,dc=com", userInfo.Subject)
+		require.Equal(t, "jane@example.com", userInfo.Email)
+		require.True(t, userInfo.EmailVerified)
+		require.Equal(t, "Jane Doe", userInfo.DisplayName)
+		role, ok := idp.MapRole(authenticator.IdentityProvider().RoleMapping, userInfo.Claims)
+		require.True(t, ok)
+		require.Equal(t, store.RoleAdmin, role)
+	}
+	userInfo, err := authenticator.Authenticate("john", "john-secret")
+	require.NoError(t, err)
+	role, ok := idp.MapRole(authenticator.IdentityProvider().RoleMapping, userInfo.Claims)
+	require.True(t, ok)
+	require.Equal(t, store.RoleUser, role)
+
+	_, err = authenticator.Authenticate("jane", "wrong")
+	require.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+	// An empty password would be an unauthenticated bind.
+	_, err = authenticator.Authenticate("jane", "")
+	require.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+	_, err = authenticator.Authenticate("unknown", "secret")
+	require.ErrorIs(t, err, ldap.ErrUserNotFound)
+	// The login is escaped in the filter.
+	_, err = authenticator.Authenticate("*", "jane-secret")
+	require.ErrorIs(t, err, ldap.ErrUserNotFound)
+}
+
+func TestAuthenticateAllowedGroup(t *testing.T) {
+	server := newTestingServer(t)
+	config := newTestingConfig(server)
+	config.AllowedGroup = "cn=slash-users,ou=groups,dc=example,dc=com"
+	authenticator, err := ldap.NewAuthenticator(config)
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate("jane", "jane-secret")
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate("john", "john-secret")
+	require.ErrorIs(t, err, ldap.ErrUserNotAllowed)
+}
+
+func TestAuthenticateServiceAccount(t *testing.T) {
+	server := newTestingServer(t)
+	config := newTestingConfig(server)
+	config.BindPassword = "wrong"
+	authenticator, err := ldap.NewAuthenticator(config)
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate("jane", "jane-secret")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ldap.ErrInvalidCredentials)
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *storepb.LDAPWorkspaceSetting
+	}{
+		{
+			name:   "url scheme",
+			config: &storepb.LDAPWorkspaceSetting{Url: "http://ldap.example.com", BaseDn: "dc=example,dc=com"},
+		},
+		{
+			name:   "base dn",
+			config: &storepb.LDAPWorkspaceSetting{Url: "ldap://ldap.example.com"},
+		},
+		{
+			name:   "user filter",
+			config: &storepb.LDAPWorkspaceSetting{Url: "ldap://ldap.example.com", BaseDn: "dc=example,dc=com", UserFilter: "(uid=jane)"},
+		},
+		{
+			name:   "admin group",
+			config: &storepb.LDAPWorkspaceSetting{Url: "ldap://ldap.example.com", BaseDn: "dc=example,dc=com", AdminGroup: "admins"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ldap.NewAuthenticator(test.config)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSignIn(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	service := idp.NewIdentityProviderService(ts, license.NewLicenseService(profile, ts))
+	server := newTestingServer(t)
+	authenticator, err := ldap.NewAuthenticator(newTestingConfig(server))
+	require.NoError(t, err)
+	existing, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "john@example.com",
+		Nickname: "john",
+	})
+	require.NoError(t, err)
+
+	// The existing user is linked by their email, and their nickname follows the directory.
+	userInfo, err := authenticator.Authenticate("john", "john-secret")
+	require.NoError(t, err)
+	user, err := service.SignIn(ctx, authenticator.IdentityProvider(), userInfo)
+	require.NoError(t, err)
+	require.Equal(t, existing.ID, user.ID)
+	require.Equal(t, "John Doe", user.Nickname)
+
+	// The new user is created, with the role of their groups.
+	userInfo, err = authenticator.Authenticate("jane", "jane-secret")
+	require.NoError(t, err)
+	user, err = service.SignIn(ctx, authenticator.IdentityProvider(), userInfo)
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", user.Email)
+	require.Equal(t, "Jane Doe", user.Nickname)
+	require.Equal(t, store.RoleAdmin, user.Role)
+}
@@ -0,0 +1,342 @@
+// Package ldaptest provides an in-process LDAP server for tests.
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// The application tags of the LDAP protocol operations.
+const (
+	tagBindRequest       = 0
+	tagBindResponse      = 1
+	tagUnbindRequest     = 2
+	tagSearchRequest     = 3
+	tagSearchResultEntry = 4
+	tagSearchResultDone  = 5
+	tagExtendedRequest   = 23
+	tagExtendedResponse  = 24
+)
+
+// Entry is an entry of the directory.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+	// Password is the password to bind as the entry. The entry cannot bind when empty.
+	Password string
+}
+
+// Server is an LDAP server with a fixed directory, which supports the simple binds and the searches
+// with the and, or, not, equality, substrings and present filters. Searches require a bind.
+type Server struct {
+	entries  []*Entry
+	listener net.Listener
+
+	mutex sync.Mutex
+	conns map[net.Conn]bool
+	wg    sync.WaitGroup
+}
+
+// NewServer starts a server of the entries on a local port.
+// The caller should call Close when finished, to shut it down.
+func NewServer(entries ...*Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	server := &Server{
+		entries:  entries,
+		listener: listener,
+		conns:    map[net.Conn]bool{},
+	}
+	server.wg.Add(1)
+	go server.serve()
+	return server, nil
+}
+
+// URL returns the url of the server, e.g. "ldap://127.0.0.1:389".
+func (s *Server) URL() string {
+	return fmt.Sprintf("ldap://%s", s.listener.Addr().String())
+}
+
+// Close shuts down the server and its connections.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.mutex.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mutex.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.conns[conn] = true
+		s.mutex.Unlock()
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handleConn(conn)
+			s.mutex.Lock()
+			delete(s.conns, conn)
+			s.mutex.Unlock()
+			_ = conn.Close()
+		}()
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	// boundDN is the DN of the entry bound on the connection, empty when anonymous.
+	boundDN := ""
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, ok := packet.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		request := packet.Children[1]
+
+		var responses []*ber.Packet
+		switch request.Tag {
+		case tagBindRequest:
+			var code uint16
+			code, boundDN = s.bind(request)
+			responses = append(responses, newResult(tagBindResponse, code))
+		case tagSearchRequest:
+			if boundDN == "" {
+				responses = append(responses, newResult(tagSearchResultDone, goldap.LDAPResultInsufficientAccessRights))
+				break
+			}
+			responses = append(responses, s.search(request)...)
+		case tagExtendedRequest:
+			// StartTLS and the other extended operations are not supported.
+			responses = append(responses, newResult(tagExtendedResponse, goldap.LDAPResultProtocolError))
+		case tagUnbindRequest:
+			return
+		default:
+			return
+		}
+
+		for _, response := range responses {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+			envelope.AppendChild(response)
+			if _, err := conn.Write(envelope.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind returns the result code of a simple bind, and the DN of the bound entry.
+// Binds without a password succeed as anonymous, like most servers do.
+func (s *Server) bind(request *ber.Packet) (uint16, string) {
+	if len(request.Children) < 3 || request.Children[2].ClassType != ber.ClassContext || request.Children[2].Tag != 0 {
+		return goldap.LDAPResultAuthMethodNotSupported, ""
+	}
+	dn := request.Children[1].Data.String()
+	password := request.Children[2].Data.String()
+	if password == "" {
+		return goldap.LDAPResultSuccess, ""
+	}
+	for _, entry := range s.entries {
+		if equalDN(entry.DN, dn) && entry.Password != "" && entry.Password == password {
+			return goldap.LDAPResultSuccess, entry.DN
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials, ""
+}
+
+func (s *Server) search(request *ber.Packet) []*ber.Packet {
+	if len(request.Children) < 8 {
+		return []*ber.Packet{newResult(tagSearchResultDone, goldap.LDAPResultProtocolError)}
+	}
+	baseDN, err := goldap.ParseDN(request.Children[0].Data.String())
+	if err != nil {
+		return []*ber.Packet{newResult(tagSearchResultDone, goldap.LDAPResultInvalidDNSyntax)}
+	}
+	scope, ok := request.Children[1].Value.(int64)
+	if !ok {
+		return []*ber.Packet{newResult(tagSearchResultDone, goldap.LDAPResultProtocolError)}
+	}
+	sizeLimit, ok := request.Children[3].Value.(int64)
+	if !ok {
+		return []*ber.Packet{newResult(tagSearchResultDone, goldap.LDAPResultProtocolError)}
+	}
+	filter := request.Children[6]
+	attributes := []string{}
+	for _, attribute := range request.Children[7].Children {
+		attributes = append(attributes, attribute.Data.String())
+	}
+
+	responses := []*ber.Packet{}
+	for _, entry := range s.entries {
+		dn, err := goldap.ParseDN(entry.DN)
+		if err != nil || !inScope(baseDN, dn, scope) || !matchFilter(entry, filter) {
+			continue
+		}
+		if sizeLimit > 0 && int64(len(responses)) >= sizeLimit {
+			return append(responses, newResult(tagSearchResultDone, goldap.LDAPResultSizeLimitExceeded))
+		}
+		responses = append(responses, newSearchResultEntry(entry, attributes))
+	}
+	return append(responses, newResult(tagSearchResultDone, goldap.LDAPResultSuccess))
+}
+
+func inScope(baseDN, dn *goldap.DN, scope int64) bool {
+	switch scope {
+	case goldap.ScopeBaseObject:
+		return baseDN.EqualFold(dn)
+	case goldap.ScopeSingleLevel:
+		return len(dn.RDNs) == len(baseDN.RDNs)+1 && baseDN.AncestorOfFold(dn)
+	default:
+		return baseDN.EqualFold(dn) || baseDN.AncestorOfFold(dn)
+	}
+}
+
+func matchFilter(entry *Entry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(entry, child) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matchFilter(entry, child) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return len(filter.Children) == 1 && !matchFilter(entry, filter.Children[0])
+	case goldap.FilterEqualityMatch:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		expected := filter.Children[1].Data.String()
+		for _, value := range getAttributeValues(entry, filter.Children[0].Data.String()) {
+			if strings.EqualFold(value, expected) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterSubstrings:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		for _, value := range getAttributeValues(entry, filter.Children[0].Data.String()) {
+			if matchSubstrings(strings.ToLower(value), filter.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		attribute := filter.Data.String()
+		return strings.EqualFold(attribute, "objectClass") || len(getAttributeValues(entry, attribute)) > 0
+	default:
+		return false
+	}
+}
+
+func matchSubstrings(value string, substrings []*ber.Packet) bool {
+	for _, substring := range substrings {
+		part := strings.ToLower(substring.Data.String())
+		switch substring.Tag {
+		case goldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, part) {
+				return false
+			}
+			value = value[len(part):]
+		case goldap.FilterSubstringsAny:
+			index := strings.Index(value, part)
+			if index < 0 {
+				return false
+			}
+			value = value[index+len(part):]
+		case goldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, part) {
+				return false
+			}
+			value = ""
+		}
+	}
+	return true
+}
+
+func getAttributeValues(entry *Entry, attribute string) []string {
+	for name, values := range entry.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func equalDN(a, b string) bool {
+	parsedA, err := goldap.ParseDN(a)
+	if err != nil {
+		return false
+	}
+	parsedB, err := goldap.ParseDN(b)
+	if err != nil {
+		return false
+	}
+	return parsedA.EqualFold(parsedB)
+}
+
+func newResult(tag ber.Tag, code uint16) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "LDAP Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, goldap.LDAPResultCodeMap[code], "Diagnostic Message"))
+	return result
+}
+
+// newSearchResultEntry returns the search result of the entry, with the attributes or all of them if none.
+func newSearchResultEntry(entry *Entry, attributes []string) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tagSearchResultEntry, nil, "Search Result Entry")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "Object Name"))
+	attributeList := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range entry.Attributes {
+		if len(attributes) > 0 && !containsFold(attributes, name) && !containsFold(attributes, "*") {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		valueSet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			valueSet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(valueSet)
+		attributeList.AppendChild(attribute)
+	}
+	result.AppendChild(attributeList)
+	return result
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp"
+)
+
+// defaultScopes are the scopes requested besides "openid" when the config has none.
+var defaultScopes = []string{"email", "profile"}
+
+// IdentityProvider signs in users with the authorization code flow with PKCE of an OpenID Connect provider.
+type IdentityProvider struct {
+	config *storepb.OIDCConfig
+	client *http.Client
+}
+
+// Session is the state of a sign-in, kept by the client from the redirect to the provider until the callback.
+type Session struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// NewIdentityProvider returns the identity provider of the config.
+func NewIdentityProvider(config *storepb.OIDCConfig) (*IdentityProvider, error) {
+	if config.GetIssuer() == "" {
+		return nil, errors.New("issuer is required")
+	}
+	if config.GetClientId() == "" {
+		return nil, errors.New("client id is required")
+	}
+	return &IdentityProvider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// NewSession returns a session with a random state, nonce and PKCE code verifier.
+func NewSession() (*Session, error) {
+	values := make([]string, 3)
+	for i := range values {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, errors.Wrap(err, "failed to generate random bytes")
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(buf)
+	}
+	return &Session{
+		State:        values[0],
+		Nonce:        values[1],
+		CodeVerifier: values[2],
+	}, nil
+}
+
+// CodeChallenge returns the S256 code challenge of the PKCE code verifier.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the url of the authorization endpoint which the user is redirected to.
+func (p *IdentityProvider) AuthCodeURL(ctx context.Context, redirectURL string, session *Session) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	authURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid authorization endpoint")
+	}
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientId)
+	query.Set("redirect_uri", redirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", session.State)
+	query.Set("nonce", session.Nonce)
+	query.Set("code_challenge", CodeChallenge(session.CodeVerifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+	return authURL.String(), nil
+}
+
+// UserInfo exchanges the authorization code for the tokens and returns the user info of the verified ID token.
+// The claims missing from the ID token are completed with the userinfo endpoint.
+func (p *IdentityProvider) UserInfo(ctx context.Context, redirectURL, code string, session *Session) (*idp.UserInfo, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.exchangeToken(ctx, discovery, redirectURL, code, session.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := p.verifyIDToken(ctx, discovery, token.IDToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	}
+	if getStringClaim(claims, "nonce") != session.Nonce {
+		return nil, errors.New("id token nonce does not match")
+	}
+	subject := getStringClaim(claims, "sub")
+	if subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+
+	if _, ok := claims["email"]; !ok && discovery.UserinfoEndpoint != "" && token.AccessToken != "" {
+		userinfoClaims, err := p.fetchUserinfo(ctx, discovery, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		if userinfoClaims["sub"] != subject {
+			return nil, errors.New("userinfo subject does not match the id token")
+		}
+		for key, value := range userinfoClaims {
+			if _, ok := claims[key]; !ok {
+				claims[key] = value
+			}
+		}
+	}
+
+	userInfo := &idp.UserInfo{
+		Subject: subject,
+		Claims:  claims,
+	}
+	userInfo.Email = getStringClaim(claims, "email")
+	// The emails are trusted only when the provider states that they are verified,
+	// as the users of some providers can set any email. Some providers send the claim as a string.
+	switch emailVerified := claims["email_verified"].(type) {
+	case bool:
+		userInfo.EmailVerified = emailVerified
+	case string:
+		userInfo.EmailVerified = strings.EqualFold(emailVerified, "true")
+	}
+	for _, key := range []string{"name", "preferred_username"} {
+		if name := getStringClaim(claims, key); name != "" {
+			userInfo.DisplayName = name
+			break
+		}
+	}
+	return userInfo, nil
+}
+
+func (p *IdentityProvider) discover(ctx context.Context) (*discoveryDocument, error) {
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	discovery := &discoveryDocument{}
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", "", discovery); err != nil {
+		return nil, errors.Wrap(err, "failed to get discovery document")
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, errors.Errorf("discovery document issuer %s does not match %s", discovery.Issuer, p.config.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("discovery document misses endpoints")
+	}
+	return discovery, nil
+}
+
+func (p *IdentityProvider) exchangeToken(ctx context.Context, discovery *discoveryDocument, redirectURL, code, codeVerifier string) (*tokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.config.ClientId)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create token request")
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(p.config.ClientId), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request token")
+	}
+	defer response.Body.Close()
+	token := &tokenResponse{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(token); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode token response with status %d", response.StatusCode)
+	}
+	if token.Error != "" {
+		return nil, errors.Errorf("failed to exchange token: %s %s", token.Error, token.ErrorDescription)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to exchange token with status %d", response.StatusCode)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response has no id token")
+	}
+	return token, nil
+}
+
+func (p *IdentityProvider) verifyIDToken(ctx context.Context, discovery *discoveryDocument, idToken string) (jwt.MapClaims, error) {
+	keys := struct {
+		Keys []*jsonWebKey `json:"keys"`
+	}{}
+	if err := p.getJSON(ctx, discovery.JWKSURI, "", &keys); err != nil {
+		return nil, errors.Wrap(err, "failed to get json web keys")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (any, error) {
+		kid := getStringClaim(t.Header, "kid")
+		for _, key := range keys.Keys {
+			if kid == "" || key.Kid == kid {
+				return key.publicKey()
+			}
+		}
+		return nil, errors.Errorf("unknown key id %s", kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *IdentityProvider) fetchUserinfo(ctx context.Context, discovery *discoveryDocument, accessToken string) (map[string]any, error) {
+	claims := map[string]any{}
+	if err := p.getJSON(ctx, discovery.UserinfoEndpoint, accessToken, &claims); err != nil {
+		return nil, errors.Wrap(err, "failed to get userinfo")
+	}
+	return claims, nil
+}
+
+func (p *IdentityProvider) getJSON(ctx context.Context, endpoint, accessToken string, value any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(value)
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, errors.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// getStringClaim returns the claim if it is a string, and an empty string otherwise.
+func getStringClaim(claims map[string]any, key string) string {
+	if value, ok := claims[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid json web key")
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
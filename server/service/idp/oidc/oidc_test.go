@@ -0,0 +1,121 @@
+package oidc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/idp/oidc"
+	"github.com/yourselfhosted/slash/server/service/idp/oidc/oidctest"
+)
+
+const redirectURL = "http://localhost:5231/api/v1/auth/sso/mock/callback"
+
+func TestIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("slash", "secret")
+	require.NoError(t, err)
+	defer issuer.Close()
+	issuer.Claims["groups"] = []string{"staff", "admins"}
+
+	identityProvider, err := oidc.NewIdentityProvider(&storepb.OIDCConfig{
+		Issuer:       issuer.URL,
+		ClientId:     "slash",
+		ClientSecret: "secret",
+	})
+	require.NoError(t, err)
+	session, err := oidc.NewSession()
+	require.NoError(t, err)
+	authCodeURL, err := identityProvider.AuthCodeURL(ctx, redirectURL, session)
+	require.NoError(t, err)
+	code, state, err := issuer.Authorize(authCodeURL)
+	require.NoError(t, err)
+	require.Equal(t, session.State, state)
+
+	userInfo, err := identityProvider.UserInfo(ctx, redirectURL, code, session)
+	require.NoError(t, err)
+	require.Equal(t, "mock-user", userInfo.Subject)
+	require.Equal(t, "mock-user@example.com", userInfo.Email)
+	require.True(t, userInfo.EmailVerified)
+	require.Equal(t, "Mock User", userInfo.DisplayName)
+	require.Equal(t, []any{"staff", "admins"}, userInfo.Claims["groups"])
+
+	// Codes can be used only once.
+	_, err = identityProvider.UserInfo(ctx, redirectURL, code, session)
+	require.Error(t, err)
+}
+
+func TestIdentityProviderUserinfo(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("slash", "")
+	require.NoError(t, err)
+	defer issuer.Close()
+	// The email is only returned by the userinfo endpoint, which does not state that it is verified.
+	delete(issuer.Claims, "email")
+	delete(issuer.Claims, "email_verified")
+	issuer.UserinfoClaims["email"] = "userinfo@example.com"
+
+	identityProvider, err := oidc.NewIdentityProvider(&storepb.OIDCConfig{
+		Issuer:   issuer.URL,
+		ClientId: "slash",
+	})
+	require.NoError(t, err)
+	session, err := oidc.NewSession()
+	require.NoError(t, err)
+	authCodeURL, err := identityProvider.AuthCodeURL(ctx, redirectURL, session)
+	require.NoError(t, err)
+	code, _, err := issuer.Authorize(authCodeURL)
+	require.NoError(t, err)
+
+	userInfo, err := identityProvider.UserInfo(ctx, redirectURL, code, session)
+	require.NoError(t, err)
+	require.Equal(t, "userinfo@example.com", userInfo.Email)
+	require.False(t, userInfo.EmailVerified)
+}
+
+func TestIdentityProviderRejectsTamperedSession(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("slash", "secret")
+	require.NoError(t, err)
+	defer issuer.Close()
+
+	tests := []struct {
+		name   string
+		config *storepb.OIDCConfig
+		tamper func(session *oidc.Session)
+	}{
+		{
+			name:   "code verifier",
+			config: &storepb.OIDCConfig{Issuer: issuer.URL, ClientId: "slash", ClientSecret: "secret"},
+			tamper: func(session *oidc.Session) { session.CodeVerifier = "tampered" },
+		},
+		{
+			name:   "nonce",
+			config: &storepb.OIDCConfig{Issuer: issuer.URL, ClientId: "slash", ClientSecret: "secret"},
+			tamper: func(session *oidc.Session) { session.Nonce = "tampered" },
+		},
+		{
+			name:   "client secret",
+			config: &storepb.OIDCConfig{Issuer: issuer.URL, ClientId: "slash", ClientSecret: "wrong"},
+			tamper: func(*oidc.Session) {},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identityProvider, err := oidc.NewIdentityProvider(test.config)
+			require.NoError(t, err)
+			session, err := oidc.NewSession()
+			require.NoError(t, err)
+			authCodeURL, err := identityProvider.AuthCodeURL(ctx, redirectURL, session)
+			require.NoError(t, err)
+			code, _, err := issuer.Authorize(authCodeURL)
+			require.NoError(t, err)
+
+			test.tamper(session)
+			_, err = identityProvider.UserInfo(ctx, redirectURL, code, session)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Package oidctest provides a mock OpenID Connect provider for tests.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/yourselfhosted/slash/server/service/idp/oidc"
+)
+
+// keyID is the id of the signing key in the json web key set.
+const keyID = "mock"
+
+// Issuer is a local OpenID Connect provider which signs in a single user without prompting,
+// with the authorization code flow and PKCE.
+type Issuer struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+	// Claims are the claims of the user in the ID token, besides the standard ones.
+	Claims map[string]any
+	// UserinfoClaims are the claims of the user returned by the userinfo endpoint only.
+	UserinfoClaims map[string]any
+
+	key   *rsa.PrivateKey
+	mutex sync.Mutex
+	// authorizations are the pending authorizations by code.
+	authorizations map[string]*authorization
+	// accessTokens are the claims of the issued access tokens.
+	accessTokens map[string]map[string]any
+}
+
+type authorization struct {
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+}
+
+// NewIssuer starts a mock issuer of the client, signing in the user with the subject "mock-user".
+// The caller should call Close when finished, to shut it down.
+func NewIssuer(clientID, clientSecret string) (*Issuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	issuer := &Issuer{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Claims: map[string]any{
+			"sub":            "mock-user",
+			"email":          "mock-user@example.com",
+			"email_verified": true,
+			"name":           "Mock User",
+		},
+		UserinfoClaims: map[string]any{},
+		key:            key,
+		authorizations: map[string]*authorization{},
+		accessTokens:   map[string]map[string]any{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.handleDiscovery)
+	mux.HandleFunc("/authorize", issuer.handleAuthorize)
+	mux.HandleFunc("/token", issuer.handleToken)
+	mux.HandleFunc("/userinfo", issuer.handleUserinfo)
+	mux.HandleFunc("/jwks", issuer.handleJWKS)
+	issuer.Server = httptest.NewServer(mux)
+	return issuer, nil
+}
+
+// Authorize follows the authorization url as the browser of the user, and returns the code and the state
+// which the issuer redirects the user back with.
+func (*Issuer) Authorize(authCodeURL string) (code, state string, err error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	response, err := client.Get(authCodeURL)
+	if err != nil {
+		return "", "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		return "", "", &url.Error{Op: "authorize", URL: authCodeURL, Err: http.ErrNotSupported}
+	}
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return "", "", err
+	}
+	return location.Query().Get("code"), location.Query().Get("state"), nil
+}
+
+func (i *Issuer) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                           i.URL,
+		"authorization_endpoint":           i.URL + "/authorize",
+		"token_endpoint":                   i.URL + "/token",
+		"userinfo_endpoint":                i.URL + "/userinfo",
+		"jwks_uri":                         i.URL + "/jwks",
+		"response_types_supported":         []string{"code"},
+		"code_challenge_methods_supported": []string{"S256"},
+	})
+}
+
+func (i *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != i.ClientID || query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	if !strings.Contains(" "+query.Get("scope")+" ", " openid ") {
+		http.Error(w, "missing openid scope", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	code := uuid.NewString()
+	i.mutex.Lock()
+	i.authorizations[code] = &authorization{
+		redirectURI:   redirectURI.String(),
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+	}
+	i.mutex.Unlock()
+
+	redirectQuery := redirectURI.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirectURI.RawQuery = redirectQuery.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_request"})
+		return
+	}
+	// Public clients without a secret only send their id in the form.
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+	}
+	if clientID != i.ClientID || clientSecret != i.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]any{"error": "invalid_client"})
+		return
+	}
+
+	// Codes can be used only once.
+	code := r.PostForm.Get("code")
+	i.mutex.Lock()
+	authorization, ok := i.authorizations[code]
+	delete(i.authorizations, code)
+	i.mutex.Unlock()
+	if !ok || authorization.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+		return
+	}
+	if oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != authorization.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant", "error_description": "code verifier does not match"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": i.URL,
+		"aud": i.ClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	if authorization.nonce != "" {
+		claims["nonce"] = authorization.nonce
+	}
+	for key, value := range i.Claims {
+		claims[key] = value
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	idToken, err := token.SignedString(i.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "server_error"})
+		return
+	}
+
+	accessToken := uuid.NewString()
+	userinfoClaims := map[string]any{}
+	for key, value := range i.Claims {
+		userinfoClaims[key] = value
+	}
+	for key, value := range i.UserinfoClaims {
+		userinfoClaims[key] = value
+	}
+	i.mutex.Lock()
+	i.accessTokens[accessToken] = userinfoClaims
+	i.mutex.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (i *Issuer) handleUserinfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	i.mutex.Lock()
+	claims, ok := i.accessTokens[accessToken]
+	i.mutex.Unlock()
+	if !ok {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, http.StatusOK, claims)
+}
+
+func (i *Issuer) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	publicKey := i.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]any{
+			{
+				"kid": keyID,
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			},
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
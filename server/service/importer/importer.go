@@ -0,0 +1,334 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/yourselfhosted/slash/internal/linktemplate"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// ConflictPolicy tells how to handle the imported shortcuts whose name is already used.
+type ConflictPolicy string
+
+const (
+	// ConflictSkip skips the shortcuts whose name is already used.
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite overwrites the shortcuts which already use the name.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// ConflictRename imports the shortcuts under a new name with a numeric suffix.
+	ConflictRename ConflictPolicy = "rename"
+)
+
+// Action is what happened to an imported shortcut.
+type Action string
+
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionSkipped Action = "skipped"
+	ActionFailed  Action = "failed"
+)
+
+// maxRenameAttempts is the maximum number of suffixes tried when renaming a shortcut.
+const maxRenameAttempts = 1000
+
+// Options are the options of an import.
+type Options struct {
+	// CreatorID is the id of the user who imports the shortcuts.
+	CreatorID int32
+	// Conflict is the policy for the shortcuts whose name is already used. Defaults to ConflictSkip.
+	Conflict ConflictPolicy
+	// DryRun reports what would happen without creating or updating any shortcut.
+	DryRun bool
+}
+
+// Result is the result of importing a shortcut.
+type Result struct {
+	// Name is the name of the shortcut in the imported file.
+	Name string
+	// ImportedName is the name the shortcut is imported under.
+	ImportedName string
+	Action       Action
+	// ShortcutID is the id of the created or updated shortcut. It is zero in dry runs.
+	ShortcutID int32
+	// Message is the reason why the shortcut is skipped or failed.
+	Message string
+}
+
+type ImportService struct {
+	Store *store.Store
+}
+
+func NewImportService(store *store.Store) *ImportService {
+	return &ImportService{
+		Store: store,
+	}
+}
+
+// importRun keeps the state of a single import.
+type importRun struct {
+	*ImportService
+	options *Options
+	creator *store.User
+	// usedNames are the names used by the shortcuts imported so far.
+	// In dry runs, they are not in the store.
+	usedNames map[string]bool
+	// defaultVisibility is the visibility of the shortcuts without one.
+	defaultVisibility storepb.Visibility
+}
+
+// Import imports the shortcuts in order, and returns the result of each of them.
+// Invalid shortcuts are reported as failed, and do not stop the import.
+func (s *ImportService) Import(ctx context.Context, shortcuts []*storepb.Shortcut, options *Options) ([]*Result, error) {
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &options.CreatorID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get creator")
+	}
+	if creator == nil {
+		return nil, errors.Errorf("creator %d not found", options.CreatorID)
+	}
+	runOptions := *options
+	run := &importRun{
+		ImportService:     s,
+		options:           &runOptions,
+		creator:           creator,
+		usedNames:         map[string]bool{},
+		defaultVisibility: storepb.Visibility_PRIVATE,
+	}
+	if run.options.Conflict == "" {
+		run.options.Conflict = ConflictSkip
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get default visibility")
+	}
+	// The imported shortcuts are not shared with any group, so the group visibility keeps the private default.
+	if workspaceSetting != nil && workspaceSetting.GetDefaultVisibility() != storepb.Visibility_VISIBILITY_UNSPECIFIED && workspaceSetting.GetDefaultVisibility() != storepb.Visibility_GROUP {
+		run.defaultVisibility = workspaceSetting.GetDefaultVisibility()
+	}
+
+	results := []*Result{}
+	for _, shortcut := range shortcuts {
+		result, err := run.importShortcut(ctx, shortcut)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import shortcut %q", shortcut.Name)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// importShortcut imports a shortcut. The error is only returned when the store fails,
+// problems with the shortcut itself are reported in the result.
+func (r *importRun) importShortcut(ctx context.Context, shortcut *storepb.Shortcut) (*Result, error) {
+	result := &Result{
+		Name:         shortcut.Name,
+		ImportedName: shortcut.Name,
+	}
+	if message := validateShortcut(shortcut); message != "" {
+		result.Action, result.Message = ActionFailed, message
+		return result, nil
+	}
+
+	existing, used, err := r.findShortcutByName(ctx, shortcut.Name)
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		switch r.options.Conflict {
+		case ConflictOverwrite:
+			return r.overwriteShortcut(ctx, result, existing, shortcut)
+		case ConflictRename:
+			name, err := r.findAvailableName(ctx, shortcut.Name)
+			if err != nil {
+				return nil, err
+			}
+			if name == "" {
+				result.Action, result.Message = ActionFailed, fmt.Sprintf("no available name for %q", shortcut.Name)
+				return result, nil
+			}
+			result.ImportedName = name
+		default:
+			result.Action, result.Message = ActionSkipped, fmt.Sprintf("name %q is already used", shortcut.Name)
+			return result, nil
+		}
+	}
+	for _, alias := range shortcut.Aliases {
+		_, used, err := r.findShortcutByName(ctx, alias)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			result.Action, result.Message = ActionFailed, fmt.Sprintf("alias %q is already used", alias)
+			return result, nil
+		}
+	}
+
+	r.markNamesUsed(append([]string{result.ImportedName}, shortcut.Aliases...))
+	result.Action = ActionCreated
+	if r.options.DryRun {
+		return result, nil
+	}
+	// The imported files have no group ids, so the shortcuts shared with groups get the default visibility.
+	visibility := shortcut.Visibility
+	if visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED || visibility == storepb.Visibility_GROUP {
+		visibility = r.defaultVisibility
+	}
+	created, err := r.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   r.creator.ID,
+		Name:        result.ImportedName,
+		Link:        shortcut.Link,
+		Title:       shortcut.Title,
+		Tags:        shortcut.Tags,
+		Description: shortcut.Description,
+		Visibility:  visibility,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+		Aliases:     shortcut.Aliases,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create shortcut")
+	}
+	if err := r.createShortcutCreateActivity(ctx, created); err != nil {
+		return nil, err
+	}
+	result.ShortcutID = created.Id
+	return result, nil
+}
+
+// overwriteShortcut replaces the content of the existing shortcut with the imported one.
+// The existing shortcut is nil in dry runs when the name is used by a previously imported shortcut.
+func (r *importRun) overwriteShortcut(ctx context.Context, result *Result, existing *storepb.Shortcut, shortcut *storepb.Shortcut) (*Result, error) {
+	if existing != nil && existing.CreatorId != r.creator.ID && r.creator.Role != store.RoleAdmin {
+		result.Action, result.Message = ActionFailed, fmt.Sprintf("name %q is used by a shortcut of another user", shortcut.Name)
+		return result, nil
+	}
+	if existing != nil && existing.DeletedTs != 0 {
+		result.Action, result.Message = ActionFailed, fmt.Sprintf("name %q is used by a shortcut in the trash", shortcut.Name)
+		return result, nil
+	}
+	for _, alias := range shortcut.Aliases {
+		aliasShortcut, used, err := r.findShortcutByName(ctx, alias)
+		if err != nil {
+			return nil, err
+		}
+		if used && (existing == nil || aliasShortcut == nil || aliasShortcut.Id != existing.Id) {
+			result.Action, result.Message = ActionFailed, fmt.Sprintf("alias %q is already used", alias)
+			return result, nil
+		}
+	}
+
+	r.markNamesUsed(shortcut.Aliases)
+	result.Action = ActionUpdated
+	if r.options.DryRun || existing == nil {
+		return result, nil
+	}
+	tag := strings.Join(shortcut.Tags, " ")
+	update := &store.UpdateShortcut{
+		ID:          existing.Id,
+		Link:        &shortcut.Link,
+		Title:       &shortcut.Title,
+		Tag:         &tag,
+		Description: &shortcut.Description,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: r.creator.ID,
+			Previous: existing,
+		},
+	}
+	// The existing shortcut keeps its visibility and groups when the imported one is shared with groups.
+	if shortcut.Visibility != storepb.Visibility_VISIBILITY_UNSPECIFIED && shortcut.Visibility != storepb.Visibility_GROUP {
+		visibility := store.Visibility(shortcut.Visibility.String())
+		update.Visibility = &visibility
+	}
+	if len(shortcut.Aliases) > 0 {
+		update.Aliases = &shortcut.Aliases
+	}
+	updated, err := r.Store.UpdateShortcut(ctx, update)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update shortcut")
+	}
+	result.ShortcutID = updated.Id
+	return result, nil
+}
+
+// findShortcutByName returns the shortcut using the name, and whether the name is used.
+// The names of the shortcuts in the trash are used too.
+// The name may be used while the shortcut is nil in dry runs.
+func (r *importRun) findShortcutByName(ctx context.Context, name string) (*storepb.Shortcut, bool, error) {
+	shortcut, err := r.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name:           &name,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get shortcut by name")
+	}
+	return shortcut, shortcut != nil || r.usedNames[name], nil
+}
+
+// findAvailableName returns the first unused name with a numeric suffix, or an empty string if there is none.
+func (r *importRun) findAvailableName(ctx context.Context, name string) (string, error) {
+	for i := 2; i < maxRenameAttempts; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		_, used, err := r.findShortcutByName(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !used {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *importRun) markNamesUsed(names []string) {
+	for _, name := range names {
+		r.usedNames[name] = true
+	}
+}
+
+func (r *importRun) createShortcutCreateActivity(ctx context.Context, shortcut *storepb.Shortcut) error {
+	payload := &storepb.ActivityShorcutCreatePayload{
+		ShortcutId: shortcut.Id,
+	}
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: shortcut.CreatorId,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	return nil
+}
+
+// validateShortcut returns the reason why the shortcut cannot be imported, or an empty string if it is valid.
+func validateShortcut(shortcut *storepb.Shortcut) string {
+	if shortcut.Name == "" || shortcut.Link == "" {
+		return "name and link are required"
+	}
+	if err := linktemplate.Validate(shortcut.Link); err != nil {
+		return fmt.Sprintf("invalid link: %v", err)
+	}
+	names := map[string]bool{shortcut.Name: true}
+	for _, alias := range shortcut.Aliases {
+		if alias == "" {
+			return "aliases cannot be empty"
+		}
+		if names[alias] {
+			return fmt.Sprintf("duplicated name %q", alias)
+		}
+		names[alias] = true
+	}
+	return ""
+}
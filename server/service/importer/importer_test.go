@@ -0,0 +1,124 @@
+package importer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/importer"
+	"github.com/yourselfhosted/slash/store"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func TestImportService(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@example.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@example.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "gh",
+		Link:       "https://github.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  other.ID,
+		Name:       "mail",
+		Link:       "https://mail.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	importService := importer.NewImportService(ts)
+	shortcuts := []*storepb.Shortcut{
+		{Name: "gh", Link: "https://github.com/yourselfhosted", Title: "Slash", Tags: []string{"code"}},
+		{Name: "mail", Link: "https://mail.example.com/inbox"},
+		{Name: "docs", Link: "https://docs.example.com", Visibility: storepb.Visibility_WORKSPACE},
+		{Name: "docs", Link: "https://docs.example.com/v2"},
+		{Name: "broken", Link: ""},
+	}
+	actions := func(results []*importer.Result) []importer.Action {
+		list := []importer.Action{}
+		for _, result := range results {
+			list = append(list, result.Action)
+		}
+		return list
+	}
+
+	// Dry runs report the changes of each policy without making them.
+	results, err := importService.Import(ctx, shortcuts, &importer.Options{CreatorID: user.ID, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, []importer.Action{importer.ActionSkipped, importer.ActionSkipped, importer.ActionCreated, importer.ActionSkipped, importer.ActionFailed}, actions(results))
+	results, err = importService.Import(ctx, shortcuts, &importer.Options{CreatorID: user.ID, Conflict: importer.ConflictOverwrite, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, []importer.Action{importer.ActionUpdated, importer.ActionFailed, importer.ActionCreated, importer.ActionUpdated, importer.ActionFailed}, actions(results))
+	results, err = importService.Import(ctx, shortcuts, &importer.Options{CreatorID: user.ID, Conflict: importer.ConflictRename, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, []importer.Action{importer.ActionCreated, importer.ActionCreated, importer.ActionCreated, importer.ActionCreated, importer.ActionFailed}, actions(results))
+	require.Equal(t, "gh-2", results[0].ImportedName)
+	require.Equal(t, "docs-2", results[3].ImportedName)
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+
+	// Overwriting only updates the shortcuts of the creator.
+	results, err = importService.Import(ctx, shortcuts, &importer.Options{CreatorID: user.ID, Conflict: importer.ConflictOverwrite})
+	require.NoError(t, err)
+	require.Equal(t, []importer.Action{importer.ActionUpdated, importer.ActionFailed, importer.ActionCreated, importer.ActionUpdated, importer.ActionFailed}, actions(results))
+	gh, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &results[0].ShortcutID})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/yourselfhosted", gh.Link)
+	require.Equal(t, []string{"code"}, gh.Tags)
+	require.Equal(t, storepb.Visibility_PUBLIC, gh.Visibility)
+	revisions, err := ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{ShortcutID: gh.Id})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(revisions))
+	require.Equal(t, "https://github.com/yourselfhosted", revisions[0].Current.Link)
+	docs, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &results[2].ShortcutID})
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.example.com/v2", docs.Link)
+	require.Equal(t, storepb.Visibility_WORKSPACE, docs.Visibility)
+	require.Equal(t, user.ID, docs.CreatorId)
+
+	// Renaming creates the shortcuts under the next available names.
+	results, err = importService.Import(ctx, shortcuts[:1], &importer.Options{CreatorID: user.ID, Conflict: importer.ConflictRename})
+	require.NoError(t, err)
+	require.Equal(t, importer.ActionCreated, results[0].Action)
+	require.Equal(t, "gh-2", results[0].ImportedName)
+	list, err = ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(list))
+
+	// The shortcuts shared with groups get the default visibility, as the imported files have no group ids.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY,
+		Value: &storepb.WorkspaceSetting_DefaultVisibility{DefaultVisibility: storepb.Visibility_GROUP},
+	})
+	require.NoError(t, err)
+	results, err = importService.Import(ctx, []*storepb.Shortcut{
+		{Name: "team", Link: "https://team.example.com", Visibility: storepb.Visibility_GROUP},
+		{Name: "wiki", Link: "https://wiki.example.com"},
+	}, &importer.Options{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []importer.Action{importer.ActionCreated, importer.ActionCreated}, actions(results))
+	for _, result := range results {
+		shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &result.ShortcutID})
+		require.NoError(t, err)
+		require.Equal(t, storepb.Visibility_PRIVATE, shortcut.Visibility)
+	}
+}
@@ -0,0 +1,312 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// Format is the format of an imported file.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	FormatHTML Format = "html"
+)
+
+// csvColumns are the columns of CSV files without a header row.
+// With a header row, the columns can be in any order, and "url" is accepted for the link.
+var csvColumns = []string{"name", "link", "title", "tags", "description", "visibility"}
+
+// slugInvalidCharRegexp matches the runs of characters which are not allowed in generated names.
+var slugInvalidCharRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// maxSlugLength is the maximum length of names generated from bookmark titles.
+const maxSlugLength = 32
+
+// DetectFormat guesses the format of the content.
+func DetectFormat(content []byte) Format {
+	trimmed := bytes.TrimSpace(content)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return FormatHTML
+	case bytes.HasPrefix(trimmed, []byte("[")), bytes.HasPrefix(trimmed, []byte("{")):
+		return FormatJSON
+	default:
+		return FormatCSV
+	}
+}
+
+// Parse parses the shortcuts from the content in the format.
+func Parse(format Format, content []byte) ([]*storepb.Shortcut, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(content)
+	case FormatJSON:
+		return parseJSON(content)
+	case FormatHTML:
+		return parseHTML(content)
+	default:
+		return nil, errors.Errorf("unsupported format %q", format)
+	}
+}
+
+func parseCSV(content []byte) ([]*storepb.Shortcut, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read csv")
+	}
+	if len(records) == 0 {
+		return []*storepb.Shortcut{}, nil
+	}
+
+	columns := csvColumns
+	firstLine := 1
+	header := []string{}
+	for _, column := range records[0] {
+		header = append(header, strings.ToLower(strings.TrimSpace(column)))
+	}
+	// The first row is a header if it names the required columns.
+	if slices.Contains(header, "name") && (slices.Contains(header, "link") || slices.Contains(header, "url")) {
+		columns = header
+		records = records[1:]
+		firstLine = 2
+	}
+
+	shortcuts := []*storepb.Shortcut{}
+	for i, record := range records {
+		shortcut := &storepb.Shortcut{}
+		for j, value := range record {
+			if j >= len(columns) {
+				break
+			}
+			value = strings.TrimSpace(value)
+			switch columns[j] {
+			case "name":
+				shortcut.Name = value
+			case "link", "url":
+				shortcut.Link = value
+			case "title":
+				shortcut.Title = value
+			case "tags":
+				shortcut.Tags = splitTags(value)
+			case "description":
+				shortcut.Description = value
+			case "visibility":
+				visibility, err := parseVisibility(value)
+				if err != nil {
+					return nil, errors.Wrapf(err, "line %d", firstLine+i)
+				}
+				shortcut.Visibility = visibility
+			}
+		}
+		shortcuts = append(shortcuts, shortcut)
+	}
+	return shortcuts, nil
+}
+
+// jsonShortcut is a shortcut in JSON files, with the field names of the API.
+type jsonShortcut struct {
+	Name        string   `json:"name"`
+	Link        string   `json:"link"`
+	Title       string   `json:"title"`
+	Tags        []string `json:"tags"`
+	Description string   `json:"description"`
+	Visibility  string   `json:"visibility"`
+	Aliases     []string `json:"aliases"`
+}
+
+func parseJSON(content []byte) ([]*storepb.Shortcut, error) {
+	list := []*jsonShortcut{}
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		object := struct {
+			Shortcuts []*jsonShortcut `json:"shortcuts"`
+		}{}
+		if err := json.Unmarshal(content, &object); err != nil {
+			return nil, errors.Wrap(err, "failed to parse json")
+		}
+		list = object.Shortcuts
+	} else if err := json.Unmarshal(content, &list); err != nil {
+		return nil, errors.Wrap(err, "failed to parse json")
+	}
+
+	shortcuts := []*storepb.Shortcut{}
+	for i, item := range list {
+		if item == nil {
+			continue
+		}
+		visibility, err := parseVisibility(item.Visibility)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shortcut %d", i+1)
+		}
+		shortcuts = append(shortcuts, &storepb.Shortcut{
+			Name:        strings.TrimSpace(item.Name),
+			Link:        strings.TrimSpace(item.Link),
+			Title:       item.Title,
+			Tags:        item.Tags,
+			Description: item.Description,
+			Visibility:  visibility,
+			Aliases:     item.Aliases,
+		})
+	}
+	return shortcuts, nil
+}
+
+// parseHTML parses the Netscape bookmark file exported by browsers.
+// Bookmarks are named after their keyword if any, otherwise after their title.
+// The names of the enclosing folders become tags, and links other than http(s) are ignored.
+func parseHTML(content []byte) ([]*storepb.Shortcut, error) {
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+	shortcuts := []*storepb.Shortcut{}
+	// folders are the names of the enclosing folders, and pendingFolder is the folder
+	// whose heading has been read, but whose list has not started yet.
+	folders := []string{}
+	// folderLists tells for each open list whether it is the list of a folder.
+	folderLists := []bool{}
+	pendingFolder := ""
+	// textTarget receives the text until the end of the current element.
+	var textTarget *string
+	var lastShortcut *storepb.Shortcut
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); err != io.EOF {
+				return nil, errors.Wrap(err, "failed to parse html")
+			}
+			for _, shortcut := range shortcuts {
+				shortcut.Description = strings.TrimSpace(shortcut.Description)
+			}
+			return shortcuts, nil
+		case html.TextToken:
+			if textTarget != nil {
+				*textTarget += string(tokenizer.Text())
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			textTarget = nil
+			switch token.Data {
+			case "h3":
+				pendingFolder = ""
+				textTarget = &pendingFolder
+			case "dl":
+				isFolder := pendingFolder != ""
+				if isFolder {
+					folders = append(folders, slugify(pendingFolder))
+				}
+				folderLists = append(folderLists, isFolder)
+				pendingFolder = ""
+			case "a":
+				lastShortcut = nil
+				shortcut := &storepb.Shortcut{}
+				keyword := ""
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "href":
+						shortcut.Link = strings.TrimSpace(attr.Val)
+					case "shortcuturl":
+						keyword = strings.TrimSpace(attr.Val)
+					case "tags":
+						shortcut.Tags = splitTags(attr.Val)
+					}
+				}
+				if u, err := url.Parse(shortcut.Link); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+					continue
+				}
+				shortcut.Name = keyword
+				for _, folder := range folders {
+					if folder != "" && !slices.Contains(shortcut.Tags, folder) {
+						shortcut.Tags = append(shortcut.Tags, folder)
+					}
+				}
+				shortcuts = append(shortcuts, shortcut)
+				lastShortcut = shortcut
+				textTarget = &shortcut.Title
+			case "dd":
+				if lastShortcut != nil {
+					textTarget = &lastShortcut.Description
+				}
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "a":
+				if lastShortcut != nil {
+					completeBookmark(lastShortcut)
+				}
+				textTarget = nil
+			case "h3":
+				pendingFolder = strings.TrimSpace(pendingFolder)
+				textTarget = nil
+			case "dl":
+				if len(folderLists) > 0 {
+					if folderLists[len(folderLists)-1] && len(folders) > 0 {
+						folders = folders[:len(folders)-1]
+					}
+					folderLists = folderLists[:len(folderLists)-1]
+				}
+				textTarget = nil
+			}
+		}
+	}
+}
+
+// completeBookmark names the bookmark after its title, or its host if it has no title.
+func completeBookmark(shortcut *storepb.Shortcut) {
+	shortcut.Title = strings.TrimSpace(shortcut.Title)
+	if shortcut.Name == "" {
+		shortcut.Name = slugify(shortcut.Title)
+	}
+	if shortcut.Name == "" {
+		if u, err := url.Parse(shortcut.Link); err == nil {
+			shortcut.Name = slugify(u.Hostname())
+		}
+	}
+}
+
+// splitTags splits the tags separated by commas or spaces.
+func splitTags(value string) []string {
+	tags := []string{}
+	for _, tag := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	}) {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func parseVisibility(value string) (storepb.Visibility, error) {
+	if value == "" {
+		return storepb.Visibility_VISIBILITY_UNSPECIFIED, nil
+	}
+	visibility, ok := storepb.Visibility_value[strings.ToUpper(value)]
+	if !ok {
+		return storepb.Visibility_VISIBILITY_UNSPECIFIED, errors.Errorf("invalid visibility %q", value)
+	}
+	return storepb.Visibility(visibility), nil
+}
+
+// slugify turns the text into a lowercase name made of letters, digits, underscores and dashes.
+func slugify(text string) string {
+	slug := strings.Trim(slugInvalidCharRegexp.ReplaceAllString(strings.ToLower(text), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
@@ -0,0 +1,131 @@
+package importer_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/importer"
+)
+
+const testBookmarkHTML = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3 ADD_DATE="1700000000">Dev Tools</H3>
+    <DL><p>
+        <DT><A HREF="https://github.com/" ADD_DATE="1700000000" SHORTCUTURL="gh" TAGS="code,git">GitHub</A>
+        <DD>Where the code lives
+        <DT><A HREF="javascript:alert(1)">Bookmarklet</A>
+    </DL><p>
+    <DT><A HREF="https://news.ycombinator.com/">Hacker News &amp; more</A>
+</DL><p>
+`
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		content string
+		want    importer.Format
+	}{
+		{
+			content: "name,link\ngh,https://github.com",
+			want:    importer.FormatCSV,
+		},
+		{
+			content: ` [{"name": "gh"}]`,
+			want:    importer.FormatJSON,
+		},
+		{
+			content: `{"shortcuts": []}`,
+			want:    importer.FormatJSON,
+		},
+		{
+			content: testBookmarkHTML,
+			want:    importer.FormatHTML,
+		},
+	}
+	for _, test := range tests {
+		if format := importer.DetectFormat([]byte(test.content)); format != test.want {
+			t.Errorf("DetectFormat(%q) got format %q, want %q.", test.content, format, test.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		format  importer.Format
+		content string
+		want    []*storepb.Shortcut
+		wantErr bool
+	}{
+		{
+			format:  importer.FormatCSV,
+			content: "gh,https://github.com,GitHub,\"code git\",The code,public\nmail,https://mail.example.com",
+			want: []*storepb.Shortcut{
+				{Name: "gh", Link: "https://github.com", Title: "GitHub", Tags: []string{"code", "git"}, Description: "The code", Visibility: storepb.Visibility_PUBLIC},
+				{Name: "mail", Link: "https://mail.example.com"},
+			},
+		},
+		{
+			format:  importer.FormatCSV,
+			content: "Link,Name,Visibility\nhttps://github.com,gh,WORKSPACE",
+			want: []*storepb.Shortcut{
+				{Name: "gh", Link: "https://github.com", Visibility: storepb.Visibility_WORKSPACE},
+			},
+		},
+		{
+			format:  importer.FormatCSV,
+			content: "name,link,visibility\ngh,https://github.com,everyone",
+			wantErr: true,
+		},
+		{
+			format:  importer.FormatJSON,
+			content: `{"shortcuts": [{"name": "gh", "link": "https://github.com", "tags": ["code"], "visibility": "PRIVATE", "aliases": ["github"], "viewCount": 3}]}`,
+			want: []*storepb.Shortcut{
+				{Name: "gh", Link: "https://github.com", Tags: []string{"code"}, Visibility: storepb.Visibility_PRIVATE, Aliases: []string{"github"}},
+			},
+		},
+		{
+			format:  importer.FormatJSON,
+			content: `[{"name": "gh", "link": "https://github.com"}]`,
+			want: []*storepb.Shortcut{
+				{Name: "gh", Link: "https://github.com"},
+			},
+		},
+		{
+			format:  importer.FormatJSON,
+			content: `[{"name": "gh"`,
+			wantErr: true,
+		},
+		{
+			format:  importer.FormatHTML,
+			content: testBookmarkHTML,
+			want: []*storepb.Shortcut{
+				{Name: "gh", Link: "https://github.com/", Title: "GitHub", Tags: []string{"code", "git", "dev-tools"}, Description: "Where the code lives"},
+				{Name: "hacker-news-more", Link: "https://news.ycombinator.com/", Title: "Hacker News & more"},
+			},
+		},
+	}
+	for _, test := range tests {
+		shortcuts, err := importer.Parse(test.format, []byte(test.content))
+		if (err != nil) != test.wantErr {
+			t.Fatalf("Parse(%q, %q) got error %v, want error %v.", test.format, test.content, err, test.wantErr)
+		}
+		if test.wantErr {
+			continue
+		}
+		if len(shortcuts) != len(test.want) {
+			t.Fatalf("Parse(%q, %q) got %d shortcuts, want %d.", test.format, test.content, len(shortcuts), len(test.want))
+		}
+		for i, shortcut := range shortcuts {
+			if len(shortcut.Tags) == 0 {
+				shortcut.Tags = nil
+			}
+			if !proto.Equal(shortcut, test.want[i]) {
+				t.Errorf("Parse(%q, %q) got shortcut %v, want %v.", test.format, test.content, shortcut, test.want[i])
+			}
+		}
+	}
+}
@@ -9,6 +9,8 @@ type ActivityType string
 const (
 	// ActivityShortcutView is the activity type of shortcut create.
 	ActivityShortcutCreate ActivityType = "shortcut.create"
+	// ActivityShortcutUpdate is the activity type of shortcut update.
+	ActivityShortcutUpdate ActivityType = "shortcut.update"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
 )
@@ -17,6 +19,8 @@ func (t ActivityType) String() string {
 	switch t {
 	case ActivityShortcutCreate:
 		return "shortcut.create"
+	case ActivityShortcutUpdate:
+		return "shortcut.update"
 	case ActivityShortcutView:
 		return "shortcut.view"
 	}
@@ -59,8 +63,13 @@ type FindActivity struct {
 	Type              ActivityType
 	Level             ActivityLevel
 	PayloadShortcutID *int32
+	// CreatedTsAfter matches the activities created at or after the timestamp.
+	CreatedTsAfter *int64
+	// CreatedTsBefore matches the activities created before the timestamp.
+	CreatedTsBefore *int64
 }
 
+// CreateActivity creates the activity. The shortcut view stats are increased along with the view activities.
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
 	return s.driver.CreateActivity(ctx, create)
 }
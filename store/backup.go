@@ -0,0 +1,27 @@
+package store
+
+import (
+	"context"
+	"sync"
+)
+
+// Backup writes a consistent snapshot of the database to the file at path.
+// The snapshot is a database file for SQLite, and a SQL dump for PostgreSQL.
+func (s *Store) Backup(ctx context.Context, path string) error {
+	return s.driver.Backup(ctx, path)
+}
+
+// Restore replaces the data of the database with the snapshot at path, which is created by Backup.
+// The caches are cleared, as they may hold the replaced data.
+func (s *Store) Restore(ctx context.Context, path string) error {
+	if err := s.driver.Restore(ctx, path); err != nil {
+		return err
+	}
+	for _, cache := range []*sync.Map{&s.workspaceSettingCache, &s.userCache, &s.userSettingCache, &s.shortcutCache} {
+		cache.Range(func(key, _ any) bool {
+			cache.Delete(key)
+			return true
+		})
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+)
+
+// CollaboratorResourceType is the type of the resources which have collaborators.
+type CollaboratorResourceType string
+
+const (
+	CollaboratorResourceTypeShortcut   CollaboratorResourceType = "SHORTCUT"
+	CollaboratorResourceTypeCollection CollaboratorResourceType = "COLLECTION"
+)
+
+// CollaboratorRole is the role of a collaborator.
+type CollaboratorRole string
+
+const (
+	// CollaboratorRoleViewer can see the resource whatever its visibility.
+	CollaboratorRoleViewer CollaboratorRole = "VIEWER"
+	// CollaboratorRoleEditor can see, update and delete the resource.
+	CollaboratorRoleEditor CollaboratorRole = "EDITOR"
+)
+
+func (e CollaboratorRole) String() string {
+	switch e {
+	case CollaboratorRoleViewer:
+		return "VIEWER"
+	case CollaboratorRoleEditor:
+		return "EDITOR"
+	}
+	return ""
+}
+
+// Collaborator is a user who a shortcut or a collection is shared with besides its visibility.
+type Collaborator struct {
+	ResourceType CollaboratorResourceType
+	ResourceID   int32
+	UserID       int32
+	Role         CollaboratorRole
+	CreatedTs    int64
+}
+
+type FindCollaborator struct {
+	ResourceType CollaboratorResourceType
+	ResourceID   *int32
+	UserID       *int32
+}
+
+type DeleteCollaborator struct {
+	ResourceType CollaboratorResourceType
+	ResourceID   int32
+	UserID       int32
+}
+
+// TransferResourceOwnership reassigns a shortcut or a collection to another user,
+// who does not need to stay a collaborator of it.
+type TransferResourceOwnership struct {
+	ResourceType CollaboratorResourceType
+	ResourceID   int32
+	ToUserID     int32
+}
+
+func (s *Store) UpsertCollaborator(ctx context.Context, upsert *Collaborator) (*Collaborator, error) {
+	return s.driver.UpsertCollaborator(ctx, upsert)
+}
+
+func (s *Store) ListCollaborators(ctx context.Context, find *FindCollaborator) ([]*Collaborator, error) {
+	return s.driver.ListCollaborators(ctx, find)
+}
+
+func (s *Store) GetCollaborator(ctx context.Context, find *FindCollaborator) (*Collaborator, error) {
+	collaborators, err := s.ListCollaborators(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(collaborators) == 0 {
+		return nil, nil
+	}
+
+	collaborator := collaborators[0]
+	return collaborator, nil
+}
+
+func (s *Store) DeleteCollaborator(ctx context.Context, delete *DeleteCollaborator) error {
+	return s.driver.DeleteCollaborator(ctx, delete)
+}
+
+func (s *Store) TransferResourceOwnership(ctx context.Context, transfer *TransferResourceOwnership) error {
+	if err := s.driver.TransferResourceOwnership(ctx, transfer); err != nil {
+		return err
+	}
+
+	if transfer.ResourceType == CollaboratorResourceTypeShortcut {
+		s.shortcutCache.Delete(transfer.ResourceID)
+	}
+	return nil
+}
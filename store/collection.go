@@ -16,6 +16,9 @@ type UpdateCollection struct {
 	Description *string
 	ShortcutIDs []int32
 	Visibility  *Visibility
+	GroupIDs    []int32
+	// DeletedTs moves the collection to the trash when not zero, and out of it when zero.
+	DeletedTs *int64
 }
 
 type FindCollection struct {
@@ -23,6 +26,10 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []Visibility
+	// The collections in the trash are excluded unless IncludeDeleted is set,
+	// and OnlyDeleted matches the collections in the trash only.
+	IncludeDeleted bool
+	OnlyDeleted    bool
 }
 
 type DeleteCollection struct {
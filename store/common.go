@@ -44,6 +44,8 @@ const (
 	VisibilityWorkspace Visibility = "WORKSPACE"
 	// VisibilityPrivate is the PRIVATE visibility.
 	VisibilityPrivate Visibility = "PRIVATE"
+	// VisibilityGroup is the GROUP visibility.
+	VisibilityGroup Visibility = "GROUP"
 )
 
 func (e Visibility) String() string {
@@ -54,6 +56,8 @@ func (e Visibility) String() string {
 		return "WORKSPACE"
 	case VisibilityPrivate:
 		return "PRIVATE"
+	case VisibilityGroup:
+		return "GROUP"
 	}
 	return "PRIVATE"
 }
@@ -0,0 +1,416 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// CopyResult is the result of copying a table.
+type CopyResult struct {
+	Table      string
+	SourceRows int64
+	TargetRows int64
+}
+
+// CopyData copies the rows of every table from the source database to the target database,
+// keeping the ids and timestamps. Both databases must be migrated to the same version,
+// and the tables of the target database must be empty.
+// The driver names are the ones of the profiles the databases are opened with.
+func CopyData(ctx context.Context, sourceDriverName string, source store.Driver, targetDriverName string, target store.Driver) ([]*CopyResult, error) {
+	sourceDialect, err := newDialect(sourceDriverName, source.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	targetDialect, err := newDialect(targetDriverName, target.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	sourceTables, err := sourceDialect.listTables(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list source tables")
+	}
+	targetTables, err := targetDialect.listTables(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list target tables")
+	}
+	for _, table := range sourceTables {
+		if !slices.Contains(targetTables, table) {
+			return nil, errors.Errorf("table %s does not exist in the target database", table)
+		}
+	}
+	// The target database enforces the foreign keys, so its tables are copied in its dependency order.
+	tables, err := targetDialect.listTablesInDependencyOrder(ctx, targetTables)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sort target tables")
+	}
+	tables = slices.DeleteFunc(tables, func(table string) bool {
+		return !slices.Contains(sourceTables, table)
+	})
+	for _, table := range tables {
+		count, err := countRows(ctx, targetDialect, table)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, errors.Errorf("table %s of the target database is not empty", table)
+		}
+	}
+
+	sourceTx, err := sourceDialect.beginSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin source transaction")
+	}
+	defer sourceTx.Rollback()
+	targetTx, err := targetDialect.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin target transaction")
+	}
+	defer targetTx.Rollback()
+
+	results := []*CopyResult{}
+	for _, table := range tables {
+		count, err := copyTable(ctx, table, sourceDialect, sourceTx, targetDialect, targetTx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to copy table %s", table)
+		}
+		results = append(results, &CopyResult{Table: table, SourceRows: count})
+	}
+	if err := targetDialect.resetSequences(ctx, targetTx, tables); err != nil {
+		return nil, errors.Wrap(err, "failed to reset sequences")
+	}
+	if err := targetTx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit target transaction")
+	}
+
+	// Verifies the row counts of the committed data.
+	for _, result := range results {
+		result.TargetRows, err = countRows(ctx, targetDialect, result.Table)
+		if err != nil {
+			return nil, err
+		}
+		if result.TargetRows != result.SourceRows {
+			return results, errors.Errorf("table %s has %d rows in the target database, want %d", result.Table, result.TargetRows, result.SourceRows)
+		}
+	}
+	return results, nil
+}
+
+// copyTable copies the rows of the table and returns the number of copied rows.
+func copyTable(ctx context.Context, table string, sourceDialect *dialect, sourceTx *sql.Tx, targetDialect *dialect, targetTx *sql.Tx) (int64, error) {
+	sourceColumns, err := sourceDialect.listColumns(ctx, table)
+	if err != nil {
+		return 0, err
+	}
+	targetColumns, err := targetDialect.listColumns(ctx, table)
+	if err != nil {
+		return 0, err
+	}
+	columns := []*column{}
+	for _, targetColumn := range targetColumns {
+		index := slices.IndexFunc(sourceColumns, func(sourceColumn *column) bool {
+			return sourceColumn.name == targetColumn.name
+		})
+		if index >= 0 {
+			// MariaDB reports the JSON columns as text, so the array columns are detected on either side.
+			columns = append(columns, &column{name: targetColumn.name, isArray: targetColumn.isArray || sourceColumns[index].isArray})
+		}
+	}
+	if len(columns) == 0 {
+		return 0, nil
+	}
+
+	names, placeholders := []string{}, []string{}
+	for i, column := range columns {
+		names = append(names, sourceDialect.quoteIdentifier(column.name))
+		placeholders = append(placeholders, targetDialect.placeholder(i+1))
+	}
+	rows, err := sourceTx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(names, ", "), sourceDialect.quoteIdentifier(table)))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	names = names[:0]
+	for _, column := range columns {
+		names = append(names, targetDialect.quoteIdentifier(column.name))
+	}
+	stmt, err := targetTx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", targetDialect.quoteIdentifier(table), strings.Join(names, ", "), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	var count int64
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return 0, err
+		}
+		for i, column := range columns {
+			values[i] = convertValue(values[i], sourceDialect, targetDialect, column.isArray)
+		}
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return 0, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// convertValue converts the value read from the source database to the representation of the target database.
+// Integer arrays are stored as "1,2,3" in SQLite, as "{1,2,3}" in Postgres and as "[1,2,3]" in MySQL.
+func convertValue(value any, sourceDialect, targetDialect *dialect, isArray bool) any {
+	if bytes, ok := value.([]byte); ok {
+		value = string(bytes)
+	}
+	text, ok := value.(string)
+	if !ok || !isArray || sourceDialect.name == targetDialect.name {
+		return value
+	}
+	elements := strings.ReplaceAll(strings.Trim(text, "{}[] "), " ", "")
+	switch targetDialect.name {
+	case "postgres":
+		return "{" + elements + "}"
+	case "mysql":
+		return "[" + elements + "]"
+	default:
+		return elements
+	}
+}
+
+func countRows(ctx context.Context, d *dialect, table string) (int64, error) {
+	var count int64
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+d.quoteIdentifier(table)).Scan(&count); err != nil {
+		return 0, errors.Wrapf(err, "failed to count rows of %s", table)
+	}
+	return count, nil
+}
+
+// column is a column which can be inserted into.
+type column struct {
+	name string
+	// isArray is true for the integer array columns.
+	isArray bool
+}
+
+// dialect runs the schema queries of a database driver.
+type dialect struct {
+	name string
+	db   *sql.DB
+}
+
+func newDialect(name string, db *sql.DB) (*dialect, error) {
+	if name != "sqlite" && name != "postgres" && name != "mysql" {
+		return nil, errors.Errorf("unsupported db driver %q", name)
+	}
+	return &dialect{name: name, db: db}, nil
+}
+
+func (d *dialect) quoteIdentifier(name string) string {
+	if d.name == "mysql" {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func (d *dialect) placeholder(n int) string {
+	if d.name == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// beginSnapshot begins a read transaction, so that all the tables are read at the same point in time.
+func (d *dialect) beginSnapshot(ctx context.Context) (*sql.Tx, error) {
+	if d.name != "sqlite" {
+		return d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	}
+	return d.db.BeginTx(ctx, nil)
+}
+
+// listTables returns the tables with data, without the migration history,
+// the internal tables and the tables of full-text search indexes, which are maintained by triggers.
+func (d *dialect) listTables(ctx context.Context) ([]string, error) {
+	query := `SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'`
+	switch d.name {
+	case "sqlite":
+		query = `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND sql NOT LIKE 'CREATE VIRTUAL TABLE%'`
+	case "mysql":
+		query = `SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'`
+	}
+	tables, err := d.queryStrings(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if d.name == "sqlite" {
+		virtualTables, err := d.queryStrings(ctx, `SELECT name FROM sqlite_master WHERE type = 'table' AND sql LIKE 'CREATE VIRTUAL TABLE%'`)
+		if err != nil {
+			return nil, err
+		}
+		// Skips the shadow tables of virtual tables, e.g. shortcut_fts_data.
+		tables = slices.DeleteFunc(tables, func(table string) bool {
+			return slices.ContainsFunc(virtualTables, func(virtualTable string) bool {
+				return strings.HasPrefix(table, virtualTable+"_")
+			})
+		})
+	}
+	tables = slices.DeleteFunc(tables, func(table string) bool {
+		return table == "migration_history"
+	})
+	slices.Sort(tables)
+	return tables, nil
+}
+
+// listColumns returns the columns of the table which can be inserted into, i.e. without the generated ones.
+func (d *dialect) listColumns(ctx context.Context, table string) ([]*column, error) {
+	columns := []*column{}
+	if d.name != "sqlite" {
+		query := `
+			SELECT column_name, data_type
+			FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER'
+			ORDER BY ordinal_position`
+		if d.name == "mysql" {
+			query = `
+				SELECT column_name, data_type
+				FROM information_schema.columns
+				WHERE table_schema = DATABASE() AND table_name = ? AND extra NOT LIKE '%GENERATED%'
+				ORDER BY ordinal_position`
+		}
+		rows, err := d.db.QueryContext(ctx, query, table)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name, dataType string
+			if err := rows.Scan(&name, &dataType); err != nil {
+				return nil, err
+			}
+			columns = append(columns, &column{name: name, isArray: dataType == "ARRAY" || dataType == "json"})
+		}
+		return columns, rows.Err()
+	}
+
+	// The hidden columns of table_xinfo are the generated ones.
+	rows, err := d.db.QueryContext(ctx, `SELECT name, type FROM pragma_table_xinfo(?) WHERE hidden = 0 ORDER BY cid`, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, columnType string
+		if err := rows.Scan(&name, &columnType); err != nil {
+			return nil, err
+		}
+		columns = append(columns, &column{name: name, isArray: strings.HasSuffix(columnType, "[]")})
+	}
+	return columns, rows.Err()
+}
+
+// listTablesInDependencyOrder sorts the tables so that each table comes after the tables it references.
+func (d *dialect) listTablesInDependencyOrder(ctx context.Context, tables []string) ([]string, error) {
+	dependencies := map[string][]string{}
+	for _, table := range tables {
+		query := `
+			SELECT DISTINCT referenced.relname
+			FROM pg_constraint
+			JOIN pg_class AS referencing ON referencing.oid = pg_constraint.conrelid
+			JOIN pg_class AS referenced ON referenced.oid = pg_constraint.confrelid
+			WHERE pg_constraint.contype = 'f' AND referencing.relname = $1 AND referencing.relnamespace = current_schema()::regnamespace`
+		switch d.name {
+		case "sqlite":
+			query = `SELECT DISTINCT "table" FROM pragma_foreign_key_list(?)`
+		case "mysql":
+			query = `
+				SELECT DISTINCT referenced_table_name
+				FROM information_schema.key_column_usage
+				WHERE table_schema = DATABASE() AND table_name = ? AND referenced_table_name IS NOT NULL`
+		}
+		referencedTables, err := d.queryStrings(ctx, query, table)
+		if err != nil {
+			return nil, err
+		}
+		dependencies[table] = referencedTables
+	}
+
+	sorted := []string{}
+	visited := map[string]bool{}
+	var visit func(table string)
+	visit = func(table string) {
+		if visited[table] {
+			return
+		}
+		visited[table] = true
+		for _, dependency := range dependencies[table] {
+			if slices.Contains(tables, dependency) {
+				visit(dependency)
+			}
+		}
+		sorted = append(sorted, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+	return sorted, nil
+}
+
+// resetSequences moves the sequences of the serial columns past the copied rows.
+// SQLite and MySQL update the sequences of autoincrement columns on insert.
+func (d *dialect) resetSequences(ctx context.Context, tx *sql.Tx, tables []string) error {
+	if d.name != "postgres" {
+		return nil
+	}
+	for _, table := range tables {
+		columns, err := d.listColumns(ctx, table)
+		if err != nil {
+			return err
+		}
+		for _, column := range columns {
+			var sequence sql.NullString
+			if err := tx.QueryRowContext(ctx, `SELECT pg_get_serial_sequence($1, $2)`, d.quoteIdentifier(table), column.name).Scan(&sequence); err != nil {
+				return err
+			}
+			if !sequence.Valid {
+				continue
+			}
+			stmt := fmt.Sprintf(`SELECT setval($1, COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)`, d.quoteIdentifier(column.name), d.quoteIdentifier(table))
+			if _, err := tx.ExecContext(ctx, stmt, sequence.String); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *dialect) queryStrings(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+	}
+	return list, rows.Err()
+}
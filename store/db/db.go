@@ -5,6 +5,7 @@ import (
 
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/mysql"
 	"github.com/yourselfhosted/slash/store/db/postgres"
 	"github.com/yourselfhosted/slash/store/db/sqlite"
 )
@@ -19,6 +20,8 @@ func NewDBDriver(profile *profile.Profile) (store.Driver, error) {
 		driver, err = sqlite.NewDB(profile)
 	case "postgres":
 		driver, err = postgres.NewDB(profile)
+	case "mysql":
+		driver, err = mysql.NewDB(profile)
 	default:
 		return nil, errors.New("unknown db driver")
 	}
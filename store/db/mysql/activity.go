@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	activity, err := createActivity(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// createActivity creates the activity in the transaction.
+func createActivity(ctx context.Context, tx *sql.Tx, create *store.Activity) (*store.Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	if create.ID != 0 {
+		// Recreates the activity with its id and timestamp, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts"), append(args, create.ID, create.CreatedTs)
+	}
+
+	stmt := "INSERT INTO activity (" + strings.Join(set, ", ") + ") VALUES (" + placeholders(len(args)) + ")"
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	if err := tx.QueryRowContext(ctx, "SELECT created_ts FROM activity WHERE id = ?", create.ID).Scan(&create.CreatedTs); err != nil {
+		return nil, err
+	}
+	// Keep the shortcut view stats up to date with the view activities.
+	if create.Type == store.ActivityShortcutView {
+		stat, err := store.ConvertActivityToShortcutViewStat(create)
+		if err != nil {
+			return nil, err
+		}
+		if err := increaseShortcutViewStat(ctx, tx, stat); err != nil {
+			return nil, err
+		}
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Type != "" {
+		where, args = append(where, "type = ?"), append(args, find.Type.String())
+	}
+	if find.Level != "" {
+		where, args = append(where, "level = ?"), append(args, find.Level.String())
+	}
+	if find.PayloadShortcutID != nil {
+		where, args = append(where, "JSON_EXTRACT(payload, '$.shortcutId') = ?"), append(args, *find.PayloadShortcutID)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	query := `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			type,
+			level,
+			payload
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ")
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Activity{}
+	for rows.Next() {
+		activity := &store.Activity{}
+		if err := rows.Scan(
+			&activity.ID,
+			&activity.CreatorID,
+			&activity.CreatedTs,
+			&activity.Type,
+			&activity.Level,
+			&activity.Payload,
+		); err != nil {
+			return nil, err
+		}
+
+		list = append(list, activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,306 @@
+package mysql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/version"
+)
+
+const (
+	// dumpHeader is the first line of the dumps.
+	dumpHeader = "-- Slash MySQL dump"
+	// migrationHistoryTable is excluded from restoring, as the schema is not restored.
+	migrationHistoryTable = "migration_history"
+	// schemaVersionPrefix is the prefix of the second line of the dumps, followed by the schema version of the data.
+	schemaVersionPrefix = "-- Schema version: "
+)
+
+// Backup writes a logical dump of the data of all tables to the file at path.
+// The dump is read in a single repeatable read transaction, so it is a consistent snapshot.
+// Every statement of the dump is on its own line, and the rows are inserted in the order of
+// the foreign keys, so the dump can be restored into an empty database with the same schema.
+func (d *DB) Backup(ctx context.Context, path string) error {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tables, err := listTablesInDependencyOrder(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list tables")
+	}
+	schemaVersion, err := getSchemaVersion(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get schema version")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to create dump file")
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+	if _, err := writer.WriteString(dumpHeader + "\n" + schemaVersionPrefix + schemaVersion + "\n"); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := dumpTable(ctx, tx, writer, table); err != nil {
+			return errors.Wrapf(err, "failed to dump table %s", table)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Restore replaces the data of all tables with the data of the dump at path, which is created by Backup.
+// The schema is left as is, so the dump has to be of the same schema version as the database.
+// The dump is replayed in a single transaction, which is rolled back on any failure.
+// InnoDB moves the auto increment counters past the restored ids by itself.
+func (d *DB) Restore(ctx context.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open dump file")
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	header, err := reader.ReadString('\n')
+	if err != nil || strings.TrimSpace(header) != dumpHeader {
+		return errors.New("invalid dump file")
+	}
+	versionLine, err := reader.ReadString('\n')
+	dumpSchemaVersion, ok := strings.CutPrefix(versionLine, schemaVersionPrefix)
+	if err != nil || !ok {
+		return errors.New("dump file has no schema version")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	schemaVersion, err := getSchemaVersion(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get schema version")
+	}
+	if dumpSchemaVersion = strings.TrimSpace(dumpSchemaVersion); dumpSchemaVersion != schemaVersion {
+		return errors.Errorf("dump of schema version %s does not match the database schema version %s", dumpSchemaVersion, schemaVersion)
+	}
+
+	tables, err := listTablesInDependencyOrder(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list tables")
+	}
+	// TRUNCATE commits implicitly, so the rows are deleted instead, the referencing tables first.
+	for i := len(tables) - 1; i >= 0; i-- {
+		if tables[i] == migrationHistoryTable {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+quoteIdentifier(tables[i])); err != nil {
+			return errors.Wrapf(err, "failed to delete rows of %s", tables[i])
+		}
+	}
+
+	skippedPrefix := fmt.Sprintf("INSERT INTO %s ", quoteIdentifier(migrationHistoryTable))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return errors.Wrap(err, "failed to read dump file")
+		}
+		stmt := strings.TrimSpace(line)
+		if stmt != "" && !strings.HasPrefix(stmt, "--") && !strings.HasPrefix(stmt, skippedPrefix) {
+			if _, err := tx.ExecContext(ctx, strings.TrimSuffix(stmt, ";")); err != nil {
+				return errors.Wrap(err, "failed to replay dump")
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return tx.Commit()
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, writer *bufio.Writer, table string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = DATABASE() AND table_name = ? AND extra NOT LIKE '%GENERATED%'
+		ORDER BY ordinal_position`,
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns := []string{}
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return err
+		}
+		columns = append(columns, quoteIdentifier(column))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	columnList := strings.Join(columns, ", ")
+	dataRows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", columnList, quoteIdentifier(table)))
+	if err != nil {
+		return err
+	}
+	defer dataRows.Close()
+	values := make([]sql.NullString, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for dataRows.Next() {
+		if err := dataRows.Scan(pointers...); err != nil {
+			return err
+		}
+		literals := []string{}
+		for _, value := range values {
+			if !value.Valid {
+				literals = append(literals, "NULL")
+			} else {
+				literals = append(literals, quoteLiteral(value.String))
+			}
+		}
+		stmt := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);\n", quoteIdentifier(table), columnList, strings.Join(literals, ", "))
+		if _, err := writer.WriteString(stmt); err != nil {
+			return err
+		}
+	}
+	return dataRows.Err()
+}
+
+// listTablesInDependencyOrder returns the tables of the current database, where the tables
+// referenced by foreign keys come before the tables referencing them.
+func listTablesInDependencyOrder(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'
+		ORDER BY table_name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	referenceRows, err := tx.QueryContext(ctx, `
+		SELECT table_name, referenced_table_name
+		FROM information_schema.key_column_usage
+		WHERE table_schema = DATABASE() AND referenced_table_name IS NOT NULL`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer referenceRows.Close()
+	references := map[string][]string{}
+	for referenceRows.Next() {
+		var source, target string
+		if err := referenceRows.Scan(&source, &target); err != nil {
+			return nil, err
+		}
+		if source != target {
+			references[source] = append(references[source], target)
+		}
+	}
+	if err := referenceRows.Err(); err != nil {
+		return nil, err
+	}
+
+	sorted, visited := []string{}, map[string]bool{}
+	for len(sorted) < len(tables) {
+		progressed := false
+		for _, table := range tables {
+			if visited[table] {
+				continue
+			}
+			ready := true
+			for _, target := range references[table] {
+				if !visited[target] && slices.Contains(tables, target) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				visited[table] = true
+				sorted = append(sorted, table)
+				progressed = true
+			}
+		}
+		if !progressed {
+			return nil, errors.New("found a cycle in the foreign keys")
+		}
+	}
+	return sorted, nil
+}
+
+func quoteIdentifier(identifier string) string {
+	return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+}
+
+// quoteLiteral quotes the string as a literal. Strings with backslashes are written in hex,
+// as backslashes are escape characters or not depending on the SQL mode.
+func quoteLiteral(literal string) string {
+	if strings.Contains(literal, `\`) || strings.ContainsAny(literal, "\n\r") {
+		return fmt.Sprintf("CONVERT(X'%s' USING utf8mb4)", hex.EncodeToString([]byte(literal)))
+	}
+	return `'` + strings.ReplaceAll(literal, `'`, `''`) + `'`
+}
+
+// getSchemaVersion returns the latest version of the migration history, which is the version of the schema.
+func getSchemaVersion(ctx context.Context, tx *sql.Tx) (string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT version FROM "+quoteIdentifier(migrationHistoryTable))
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	versions := []string{}
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return "", err
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	sort.Sort(version.SortVersion(versions))
+	return versions[len(versions)-1], nil
+}
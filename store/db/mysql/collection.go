@@ -0,0 +1,208 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
+	shortcutIDs, err := marshalIDs(create.ShortcutIds)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, err := marshalIDs(create.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "deleted_ts", "group_ids"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, shortcutIDs, create.Visibility.String(), create.DeletedTs, groupIDs}
+	if create.Id != 0 {
+		// Recreates the collection with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.Id, create.CreatedTs, create.UpdatedTs)
+	}
+
+	stmt := "INSERT INTO collection (" + strings.Join(set, ", ") + ") VALUES (" + placeholders(len(args)) + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.Id = int32(id)
+	if err := d.db.QueryRowContext(ctx, "SELECT created_ts, updated_ts FROM collection WHERE id = ?", create.Id).Scan(
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	collection := create
+	return collection, nil
+}
+
+func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*storepb.Collection, error) {
+	set, args := []string{}, []any{}
+	if update.Name != nil {
+		set, args = append(set, "name = ?"), append(args, *update.Name)
+	}
+	if update.Title != nil {
+		set, args = append(set, "title = ?"), append(args, *update.Title)
+	}
+	if update.Description != nil {
+		set, args = append(set, "description = ?"), append(args, *update.Description)
+	}
+	if update.ShortcutIDs != nil {
+		shortcutIDs, err := marshalIDs(update.ShortcutIDs)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "shortcut_ids = ?"), append(args, shortcutIDs)
+	}
+	if update.Visibility != nil {
+		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
+	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = ?"), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		groupIDs, err := marshalIDs(update.GroupIDs)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "group_ids = ?"), append(args, groupIDs)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	stmt := "UPDATE collection SET " + strings.Join(set, ", ") + " WHERE id = ?"
+	args = append(args, update.ID)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListCollections(ctx, &store.FindCollection{ID: &update.ID, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("collection %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([]*storepb.Collection, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		list := []string{}
+		for _, visibility := range v {
+			list, args = append(list, "?"), append(args, visibility)
+		}
+		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
+	}
+	if find.OnlyDeleted {
+		where = append(where, "deleted_ts > 0")
+	} else if !find.IncludeDeleted {
+		where = append(where, "deleted_ts = 0")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			name,
+			title,
+			description,
+			shortcut_ids,
+			visibility,
+			deleted_ts,
+			group_ids
+		FROM collection
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*storepb.Collection, 0)
+	for rows.Next() {
+		collection := &storepb.Collection{}
+		var shortcutIDs, visibility, groupIDs string
+		if err := rows.Scan(
+			&collection.Id,
+			&collection.CreatorId,
+			&collection.CreatedTs,
+			&collection.UpdatedTs,
+			&collection.Name,
+			&collection.Title,
+			&collection.Description,
+			&shortcutIDs,
+			&visibility,
+			&collection.DeletedTs,
+			&groupIDs,
+		); err != nil {
+			return nil, err
+		}
+
+		collection.ShortcutIds = []int32{}
+		if err := json.Unmarshal([]byte(shortcutIDs), &collection.ShortcutIds); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal shortcut ids")
+		}
+		groupIDList := []int32{}
+		if err := json.Unmarshal([]byte(groupIDs), &groupIDList); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal group ids")
+		}
+		if len(groupIDList) > 0 {
+			collection.GroupIds = groupIDList
+		}
+		collection.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
+		list = append(list, collection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM collection WHERE id = ?", delete.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// marshalIDs encodes the ids as the JSON array of the shortcut_ids and group_ids columns.
+func marshalIDs(ids []int32) (string, error) {
+	if ids == nil {
+		ids = []int32{}
+	}
+	bytes, err := json.Marshal(ids)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal ids")
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,200 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateGroup(ctx context.Context, create *store.Group) (*store.Group, error) {
+	set := []string{"name", "description"}
+	args := []any{create.Name, create.Description}
+	if create.ID != 0 {
+		// Recreates the group with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.ID, create.CreatedTs, create.UpdatedTs)
+	}
+
+	stmt := "INSERT INTO user_group (" + strings.Join(set, ", ") + ") VALUES (" + placeholders(len(args)) + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	if err := d.db.QueryRowContext(ctx, "SELECT created_ts, updated_ts FROM user_group WHERE id = ?", create.ID).Scan(
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	group := create
+	return group, nil
+}
+
+func (d *DB) UpdateGroup(ctx context.Context, update *store.UpdateGroup) (*store.Group, error) {
+	set, args := []string{}, []any{}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	stmt := "UPDATE user_group SET " + strings.Join(set, ", ") + " WHERE id = ?"
+	args = append(args, update.ID)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListGroups(ctx, &store.FindGroup{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("group not found")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListGroups(ctx context.Context, find *store.FindGroup) ([]*store.Group, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.IDList; v != nil {
+		if len(v) == 0 {
+			where = append(where, "1 = 0")
+		} else {
+			for _, id := range v {
+				args = append(args, id)
+			}
+			where = append(where, fmt.Sprintf("id IN (%s)", placeholders(len(v))))
+		}
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "id IN (SELECT group_id FROM user_group_member WHERE user_id = ?)"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			name,
+			description
+		FROM user_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY name ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Group, 0)
+	for rows.Next() {
+		group := &store.Group{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+			&group.Name,
+			&group.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteGroup deletes the group, while the foreign keys delete its members and shares.
+func (d *DB) DeleteGroup(ctx context.Context, delete *store.DeleteGroup) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM user_group WHERE id = ?", delete.ID)
+	return err
+}
+
+func (d *DB) UpsertGroupMember(ctx context.Context, upsert *store.GroupMember) (*store.GroupMember, error) {
+	stmt := `
+		INSERT INTO user_group_member (
+			group_id, user_id
+		)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE
+			group_id = VALUES(group_id)
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.GroupID, upsert.UserID); err != nil {
+		return nil, err
+	}
+	if err := d.db.QueryRowContext(ctx, "SELECT created_ts FROM user_group_member WHERE group_id = ? AND user_id = ?", upsert.GroupID, upsert.UserID).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	member := upsert
+	return member, nil
+}
+
+func (d *DB) ListGroupMembers(ctx context.Context, find *store.FindGroupMember) ([]*store.GroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			group_id,
+			user_id,
+			created_ts
+		FROM user_group_member
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.GroupMember, 0)
+	for rows.Next() {
+		member := &store.GroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteGroupMember(ctx context.Context, delete *store.DeleteGroupMember) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM user_group_member WHERE group_id = ? AND user_id = ?", delete.GroupID, delete.UserID)
+	return err
+}
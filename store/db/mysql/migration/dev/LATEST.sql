@@ -0,0 +1,175 @@
+-- migration_history
+CREATE TABLE migration_history (
+  version VARCHAR(256) NOT NULL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- workspace_setting
+CREATE TABLE workspace_setting (
+  `key` VARCHAR(256) NOT NULL UNIQUE,
+  value LONGTEXT NOT NULL
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- user
+CREATE TABLE `user` (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  updated_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  row_status VARCHAR(256) NOT NULL DEFAULT 'NORMAL' CHECK (row_status IN ('NORMAL', 'ARCHIVED')),
+  email VARCHAR(256) NOT NULL UNIQUE,
+  nickname VARCHAR(256) NOT NULL,
+  password_hash VARCHAR(256) NOT NULL,
+  role VARCHAR(256) NOT NULL DEFAULT 'USER' CHECK (role IN ('ADMIN', 'USER'))
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_user_email ON `user`(email);
+
+-- user_setting
+CREATE TABLE user_setting (
+  user_id INT NOT NULL,
+  `key` VARCHAR(256) NOT NULL,
+  value LONGTEXT NOT NULL,
+  PRIMARY KEY (user_id, `key`),
+  FOREIGN KEY (user_id) REFERENCES `user`(id)
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- shortcut
+CREATE TABLE shortcut (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  creator_id INT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  updated_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  row_status VARCHAR(256) NOT NULL DEFAULT 'NORMAL' CHECK (row_status IN ('NORMAL', 'ARCHIVED')),
+  name VARCHAR(256) NOT NULL UNIQUE,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT (''),
+  description TEXT NOT NULL DEFAULT (''),
+  visibility VARCHAR(256) NOT NULL DEFAULT 'PRIVATE' CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')),
+  tag TEXT NOT NULL DEFAULT (''),
+  og_metadata TEXT NOT NULL DEFAULT ('{}'),
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  fallback_link TEXT NOT NULL DEFAULT (''),
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  FOREIGN KEY (creator_id) REFERENCES `user`(id)
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  shortcut_id INT NOT NULL,
+  alias VARCHAR(256) NOT NULL UNIQUE,
+  FOREIGN KEY (shortcut_id) REFERENCES shortcut(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
+-- activity
+CREATE TABLE activity (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  creator_id INT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  type VARCHAR(256) NOT NULL DEFAULT '',
+  level VARCHAR(256) NOT NULL DEFAULT 'INFO' CHECK (level IN ('INFO', 'WARN', 'ERROR')),
+  payload TEXT NOT NULL DEFAULT ('{}')
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- shortcut_view_stat
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INT NOT NULL,
+  day_ts BIGINT NOT NULL,
+  referer VARCHAR(500) NOT NULL DEFAULT '',
+  device VARCHAR(128) NOT NULL DEFAULT '',
+  browser VARCHAR(128) NOT NULL DEFAULT '',
+  count INT NOT NULL DEFAULT 0,
+  UNIQUE(shortcut_id, day_ts, referer, device, browser)
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- collection
+CREATE TABLE collection (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  creator_id INT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  updated_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  name VARCHAR(256) NOT NULL UNIQUE,
+  title TEXT NOT NULL DEFAULT (''),
+  description TEXT NOT NULL DEFAULT (''),
+  shortcut_ids JSON NOT NULL,
+  visibility VARCHAR(256) NOT NULL DEFAULT 'PRIVATE' CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')),
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids JSON NOT NULL DEFAULT (JSON_ARRAY()),
+  FOREIGN KEY (creator_id) REFERENCES `user`(id)
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_collection_name ON collection(name);
+
+-- user_group
+CREATE TABLE user_group (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  updated_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  name VARCHAR(256) NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ('')
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INT NOT NULL,
+  user_id INT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(group_id, user_id),
+  FOREIGN KEY (group_id) REFERENCES user_group(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES `user`(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- shortcut_group
+CREATE TABLE shortcut_group (
+  shortcut_id INT NOT NULL,
+  group_id INT NOT NULL,
+  UNIQUE(shortcut_id, group_id),
+  FOREIGN KEY (shortcut_id) REFERENCES shortcut(id) ON DELETE CASCADE,
+  FOREIGN KEY (group_id) REFERENCES user_group(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_shortcut_group_group_id ON shortcut_group(group_id);
+
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INT NOT NULL,
+  user_id INT NOT NULL,
+  role VARCHAR(256) NOT NULL DEFAULT 'VIEWER' CHECK (role IN ('VIEWER', 'EDITOR')),
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(shortcut_id, user_id),
+  FOREIGN KEY (shortcut_id) REFERENCES shortcut(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES `user`(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_shortcut_collaborator_user_id ON shortcut_collaborator(user_id);
+
+-- collection_collaborator
+CREATE TABLE collection_collaborator (
+  collection_id INT NOT NULL,
+  user_id INT NOT NULL,
+  role VARCHAR(256) NOT NULL DEFAULT 'VIEWER' CHECK (role IN ('VIEWER', 'EDITOR')),
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(collection_id, user_id),
+  FOREIGN KEY (collection_id) REFERENCES collection(id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES `user`(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_collection_collaborator_user_id ON collection_collaborator(user_id);
+
+-- user_identity
+CREATE TABLE user_identity (
+  user_id INT NOT NULL,
+  provider_id VARCHAR(256) NOT NULL,
+  subject VARCHAR(256) NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(provider_id, subject),
+  FOREIGN KEY (user_id) REFERENCES `user`(id) ON DELETE CASCADE
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX idx_user_identity_user_id ON user_identity(user_id);
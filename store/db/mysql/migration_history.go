@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
+	stmt := `
+		INSERT INTO migration_history (
+			version
+		)
+		VALUES (?)
+		ON DUPLICATE KEY UPDATE
+			version = VALUES(version)
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.Version); err != nil {
+		return nil, err
+	}
+
+	var migrationHistory store.MigrationHistory
+	if err := d.db.QueryRowContext(ctx, "SELECT version, created_ts FROM migration_history WHERE version = ?", upsert.Version).Scan(
+		&migrationHistory.Version,
+		&migrationHistory.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return &migrationHistory, nil
+}
+
+func (d *DB) ListMigrationHistories(ctx context.Context, _ *store.FindMigrationHistory) ([]*store.MigrationHistory, error) {
+	query := "SELECT version, created_ts FROM migration_history ORDER BY created_ts DESC"
+	rows, err := d.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.MigrationHistory, 0)
+	for rows.Next() {
+		var migrationHistory store.MigrationHistory
+		if err := rows.Scan(
+			&migrationHistory.Version,
+			&migrationHistory.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+
+		list = append(list, &migrationHistory)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
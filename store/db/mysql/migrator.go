@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/version"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	latestSchemaFileName = "LATEST.sql"
+)
+
+//go:embed migration
+var migrationFS embed.FS
+
+// Migrate applies the pending migrations to the database.
+// DDL statements are committed implicitly in MySQL, so the migrations are not run in transactions.
+func (d *DB) Migrate(ctx context.Context) error {
+	migrations, err := d.ListPendingMigrations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending migrations")
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	println("start migrate")
+	for _, migration := range migrations {
+		println("applying migration for", migration.Version)
+		// Loop over all migration files and execute them in order.
+		for _, filename := range migration.Files {
+			if err := d.executeFile(ctx, filename); err != nil {
+				return errors.Wrapf(err, "failed to apply migration for %s", migration.Version)
+			}
+		}
+		// Upsert the newest version to migration_history.
+		if _, err := d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{Version: migration.Version}); err != nil {
+			return errors.Wrapf(err, "failed to upsert migration history with version: %s", migration.Version)
+		}
+	}
+	println("end migrate")
+	return nil
+}
+
+// ListPendingMigrations returns the migrations which are applied by the next Migrate.
+// A new database gets the latest schema, and an existing one the migrations of the minor versions
+// between the latest migration history version and the current version.
+func (d *DB) ListPendingMigrations(ctx context.Context) ([]*store.PendingMigration, error) {
+	currentVersion := version.GetCurrentVersion(d.profile.Mode)
+	if d.profile.IsDev() {
+		var count int
+		if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE()").Scan(&count); err != nil {
+			return nil, errors.Wrap(err, "failed to query database tables")
+		}
+		if count != 0 {
+			return []*store.PendingMigration{}, nil
+		}
+		return []*store.PendingMigration{
+			{Version: currentVersion, Files: []string{"migration/dev/" + latestSchemaFileName}},
+		}, nil
+	}
+
+	migrationHistoryList, err := d.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
+	if err != nil {
+		// New databases do not have the migration_history table yet.
+		migrationHistoryList = nil
+	}
+	// If there is no migration history, we should apply the latest schema.
+	if len(migrationHistoryList) == 0 {
+		return []*store.PendingMigration{
+			{Version: currentVersion, Files: []string{"migration/prod/" + latestSchemaFileName}},
+		}, nil
+	}
+
+	migrationHistoryVersionList := []string{}
+	for _, migrationHistory := range migrationHistoryList {
+		migrationHistoryVersionList = append(migrationHistoryVersionList, migrationHistory.Version)
+	}
+	sort.Sort(version.SortVersion(migrationHistoryVersionList))
+	latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
+	// If the latest migration history version is greater than or equal to the current version, we will not apply any migration.
+	if !version.IsVersionGreaterThan(version.GetSchemaVersion(currentVersion), latestMigrationHistoryVersion) {
+		return []*store.PendingMigration{}, nil
+	}
+
+	migrations := []*store.PendingMigration{}
+	for _, minorVersion := range getMinorVersionList() {
+		normalizedVersion := minorVersion + ".0"
+		if version.IsVersionGreaterThan(normalizedVersion, latestMigrationHistoryVersion) && version.IsVersionGreaterOrEqualThan(currentVersion, normalizedVersion) {
+			filenames, err := fs.Glob(migrationFS, fmt.Sprintf("migration/prod/%s/*.sql", minorVersion))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read ddl files")
+			}
+			sort.Strings(filenames)
+			migrations = append(migrations, &store.PendingMigration{Version: normalizedVersion, Files: filenames})
+		}
+	}
+	return migrations, nil
+}
+
+// executeFile runs the statements of the SQL file one by one,
+// as the driver does not run multiple statements at once by default.
+func (d *DB) executeFile(ctx context.Context, filename string) error {
+	buf, err := migrationFS.ReadFile(filename)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read migration file, filename=%s", filename)
+	}
+	for _, stmt := range strings.Split(string(buf), ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if _, err := d.db.ExecContext(ctx, stmt); err != nil {
+			return errors.Wrapf(err, "migrate error: %s", stmt)
+		}
+	}
+	return nil
+}
+
+// minorDirRegexp is a regular expression for minor version directory.
+var minorDirRegexp = regexp.MustCompile(`^migration/prod/[0-9]+\.[0-9]+$`)
+
+func getMinorVersionList() []string {
+	minorVersionList := []string{}
+
+	if err := fs.WalkDir(migrationFS, "migration", func(path string, file fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if file.IsDir() && minorDirRegexp.MatchString(path) {
+			minorVersionList = append(minorVersionList, file.Name())
+		}
+
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+
+	sort.Sort(version.SortVersion(minorVersionList))
+	return minorVersionList
+}
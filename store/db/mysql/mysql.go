@@ -0,0 +1,47 @@
+package mysql
+
+import (
+	"database/sql"
+
+	// Import the MySQL driver.
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+type DB struct {
+	db      *sql.DB
+	profile *profile.Profile
+}
+
+// NewDB opens a MySQL or MariaDB database with a DSN like "user:password@tcp(localhost:3306)/slash".
+// The driver connects with the utf8mb4 charset by default, which is the charset of the tables.
+func NewDB(profile *profile.Profile) (store.Driver, error) {
+	if profile == nil {
+		return nil, errors.New("profile is nil")
+	}
+	if profile.DSN == "" {
+		return nil, errors.New("dsn required")
+	}
+
+	db, err := sql.Open("mysql", profile.DSN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open database: %s", profile.DSN)
+	}
+
+	var driver store.Driver = &DB{
+		db:      db,
+		profile: profile,
+	}
+	return driver, nil
+}
+
+func (d *DB) GetDB() *sql.DB {
+	return d.db
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
@@ -0,0 +1,506 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "start_ts", "expire_ts", "fallback_link", "deleted_ts"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.StartTs, create.ExpireTs, create.FallbackLink, create.DeletedTs}
+	if create.OgMetadata != nil {
+		set = append(set, "og_metadata")
+		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(openGraphMetadataBytes))
+	}
+	if create.Id != 0 {
+		// Recreates the shortcut with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.Id, create.CreatedTs, create.UpdatedTs)
+	}
+	if create.RowStatus != storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+		set, args = append(set, "row_status"), append(args, create.RowStatus.String())
+	}
+
+	stmt := fmt.Sprintf(`
+		INSERT INTO shortcut (%s)
+		VALUES (%s)
+	`, strings.Join(set, ","), placeholders(len(args)))
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.Id = int32(id)
+	var rowStatus string
+	if err := tx.QueryRowContext(ctx, "SELECT created_ts, updated_ts, row_status FROM shortcut WHERE id = ?", create.Id).Scan(
+		&create.CreatedTs,
+		&create.UpdatedTs,
+		&rowStatus,
+	); err != nil {
+		return nil, err
+	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
+	if err := replaceShortcutAliases(ctx, tx, create.Id, create.Aliases); err != nil {
+		return nil, err
+	}
+	if err := replaceShortcutGroups(ctx, tx, create.Id, create.GroupIds); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	shortcut := create
+	return shortcut, nil
+}
+
+func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+	set, args := []string{}, []any{}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
+	if update.Name != nil {
+		set, args = append(set, "name = ?"), append(args, *update.Name)
+	}
+	if update.Link != nil {
+		set, args = append(set, "link = ?"), append(args, *update.Link)
+	}
+	if update.Title != nil {
+		set, args = append(set, "title = ?"), append(args, *update.Title)
+	}
+	if update.Description != nil {
+		set, args = append(set, "description = ?"), append(args, *update.Description)
+	}
+	if update.Visibility != nil {
+		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
+	}
+	if update.Tag != nil {
+		set, args = append(set, "tag = ?"), append(args, *update.Tag)
+	}
+	if update.StartTs != nil {
+		set, args = append(set, "start_ts = ?"), append(args, *update.StartTs)
+	}
+	if update.ExpireTs != nil {
+		set, args = append(set, "expire_ts = ?"), append(args, *update.ExpireTs)
+	}
+	if update.FallbackLink != nil {
+		set, args = append(set, "fallback_link = ?"), append(args, *update.FallbackLink)
+	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = ?"), append(args, *update.DeletedTs)
+	}
+	if update.OpenGraphMetadata != nil {
+		openGraphMetadataBytes, err := protojson.Marshal(update.OpenGraphMetadata)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal activity payload")
+		}
+		set, args = append(set, "og_metadata = ?"), append(args, string(openGraphMetadataBytes))
+	}
+	if len(set) == 0 && update.Aliases == nil && update.GroupIDs == nil {
+		return nil, errors.New("no update specified")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if len(set) > 0 {
+		args = append(args, update.ID)
+		stmt := fmt.Sprintf(`
+			UPDATE shortcut
+			SET %s
+			WHERE id = ?
+		`, strings.Join(set, ","))
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return nil, err
+		}
+	}
+	if update.Aliases != nil {
+		if err := replaceShortcutAliases(ctx, tx, update.ID, *update.Aliases); err != nil {
+			return nil, err
+		}
+	}
+	if update.GroupIDs != nil {
+		if err := replaceShortcutGroups(ctx, tx, update.ID, *update.GroupIDs); err != nil {
+			return nil, err
+		}
+	}
+	if update.Revision != nil {
+		if err := createShortcutRevision(ctx, tx, update.ID, update.Revision); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListShortcuts(ctx, &store.FindShortcut{
+		ID:             &update.ID,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("shortcut %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
+	return listShortcuts(ctx, d.db, find)
+}
+
+// listShortcuts lists the shortcuts with the db or a transaction.
+func listShortcuts(ctx context.Context, db querier, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, "?")
+			args = append(args, id)
+		}
+		if len(list) == 0 {
+			where = append(where, "1 = 0")
+		} else {
+			where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ", ")))
+		}
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "(name = ? OR id IN (SELECT shortcut_id FROM shortcut_alias WHERE alias = ?))"), append(args, *v, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		list := []string{}
+		for _, visibility := range v {
+			list = append(list, "?")
+			args = append(args, visibility)
+		}
+		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
+	}
+	if v := find.ExpiredBefore; v != nil {
+		where, args = append(where, "expire_ts > 0 AND expire_ts <= ?"), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		where, args = append(where, shortcutViewerCondition), append(args, *v, *v, *v)
+	}
+	if v := find.NamePrefix; v != nil {
+		where, args = append(where, "LOWER(name) LIKE LOWER(?)"), append(args, escapeLikePattern(*v)+"%")
+	}
+	if v := find.SimilarName; v != nil {
+		pattern, minLength, maxLength := escapeLikePattern(v.Name)+"%", utf8.RuneCountInString(v.Name)-v.MaxDistance, utf8.RuneCountInString(v.Name)+v.MaxDistance
+		where = append(where, `(LOWER(name) LIKE LOWER(?) OR CHAR_LENGTH(name) BETWEEN ? AND ? OR id IN (
+			SELECT shortcut_id FROM shortcut_alias WHERE LOWER(alias) LIKE LOWER(?) OR CHAR_LENGTH(alias) BETWEEN ? AND ?
+		))`)
+		args = append(args, pattern, minLength, maxLength, pattern, minLength, maxLength)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "updated_ts >= ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "updated_ts < ?"), append(args, *v)
+	}
+	if find.OnlyDeleted {
+		where = append(where, "deleted_ts > 0")
+	} else if !find.IncludeDeleted {
+		where = append(where, "deleted_ts = 0")
+	}
+
+	limitClause := ""
+	if find.Limit != nil {
+		limitClause += fmt.Sprintf(" LIMIT %d", *find.Limit)
+	}
+	if find.Offset != nil {
+		if find.Limit == nil {
+			// MySQL requires a limit with an offset, so the largest limit is used.
+			limitClause = " LIMIT 18446744073709551615"
+		}
+		limitClause += fmt.Sprintf(" OFFSET %d", *find.Offset)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			row_status,
+			name,
+			link,
+			title,
+			description,
+			visibility,
+			tag,
+			og_metadata,
+			start_ts,
+			expire_ts,
+			fallback_link,
+			deleted_ts
+		FROM shortcut
+		WHERE %s
+		ORDER BY %s%s
+	`, strings.Join(where, " AND "), getShortcutOrderBy(find), limitClause), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*storepb.Shortcut, 0)
+	for rows.Next() {
+		shortcut := &storepb.Shortcut{}
+		var rowStatus, visibility, tags, openGraphMetadataString string
+		if err := rows.Scan(
+			&shortcut.Id,
+			&shortcut.CreatorId,
+			&shortcut.CreatedTs,
+			&shortcut.UpdatedTs,
+			&rowStatus,
+			&shortcut.Name,
+			&shortcut.Link,
+			&shortcut.Title,
+			&shortcut.Description,
+			&visibility,
+			&tags,
+			&openGraphMetadataString,
+			&shortcut.StartTs,
+			&shortcut.ExpireTs,
+			&shortcut.FallbackLink,
+			&shortcut.DeletedTs,
+		); err != nil {
+			return nil, err
+		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
+		shortcut.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
+		shortcut.Tags = filterTags(strings.Split(tags, " "))
+		var ogMetadata storepb.OpenGraphMetadata
+		if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
+			return nil, err
+		}
+		shortcut.OgMetadata = &ogMetadata
+		list = append(list, shortcut)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := attachShortcutAliases(ctx, db, list); err != nil {
+		return nil, err
+	}
+	if err := attachShortcutGroups(ctx, db, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The view stats have no foreign key to the shortcut, unlike the other child rows which are deleted by cascade.
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shortcut_view_stat WHERE shortcut_id = ?", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shortcut WHERE id = ?", delete.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// attachShortcutAliases fills in the aliases of the given shortcuts.
+func attachShortcutAliases(ctx context.Context, db querier, list []*storepb.Shortcut) error {
+	if len(list) == 0 {
+		return nil
+	}
+	shortcutMap := map[int32]*storepb.Shortcut{}
+	args := []any{}
+	for _, shortcut := range list {
+		shortcutMap[shortcut.Id] = shortcut
+		args = append(args, shortcut.Id)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT shortcut_id, alias
+		FROM shortcut_alias
+		WHERE shortcut_id IN (%s)
+		ORDER BY alias ASC
+	`, placeholders(len(args))), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var shortcutID int32
+		var alias string
+		if err := rows.Scan(&shortcutID, &alias); err != nil {
+			return err
+		}
+		if shortcut, ok := shortcutMap[shortcutID]; ok {
+			shortcut.Aliases = append(shortcut.Aliases, alias)
+		}
+	}
+	return rows.Err()
+}
+
+// attachShortcutGroups fills in the groups the given shortcuts are shared with.
+func attachShortcutGroups(ctx context.Context, db querier, list []*storepb.Shortcut) error {
+	if len(list) == 0 {
+		return nil
+	}
+	shortcutMap := map[int32]*storepb.Shortcut{}
+	args := []any{}
+	for _, shortcut := range list {
+		shortcutMap[shortcut.Id] = shortcut
+		args = append(args, shortcut.Id)
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT shortcut_id, group_id
+		FROM shortcut_group
+		WHERE shortcut_id IN (%s)
+		ORDER BY group_id ASC
+	`, placeholders(len(args))), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var shortcutID, groupID int32
+		if err := rows.Scan(&shortcutID, &groupID); err != nil {
+			return err
+		}
+		if shortcut, ok := shortcutMap[shortcutID]; ok {
+			shortcut.GroupIds = append(shortcut.GroupIds, groupID)
+		}
+	}
+	return rows.Err()
+}
+
+func replaceShortcutAliases(ctx context.Context, tx *sql.Tx, shortcutID int32, aliases []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shortcut_alias WHERE shortcut_id = ?", shortcutID); err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO shortcut_alias (shortcut_id, alias) VALUES (?, ?)", shortcutID, alias); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaceShortcutGroups(ctx context.Context, tx *sql.Tx, shortcutID int32, groupIDs []int32) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shortcut_group WHERE shortcut_id = ?", shortcutID); err != nil {
+		return err
+	}
+	for _, groupID := range groupIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO shortcut_group (shortcut_id, group_id) VALUES (?, ?)", shortcutID, groupID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createShortcutRevision records the revision of the updated shortcut in the transaction of the update.
+func createShortcutRevision(ctx context.Context, tx *sql.Tx, shortcutID int32, revision *store.UpdateShortcutRevision) error {
+	list, err := listShortcuts(ctx, tx, &store.FindShortcut{
+		ID:             &shortcutID,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return errors.Errorf("shortcut %d not found", shortcutID)
+	}
+	activity, err := store.ConvertShortcutRevisionToActivity(revision, list[0])
+	if err != nil {
+		return err
+	}
+	if activity == nil {
+		return nil
+	}
+	_, err = createActivity(ctx, tx, activity)
+	return err
+}
+
+// shortcutViewerCondition matches the shortcuts visible to a viewer, with the viewer id as its three arguments.
+const shortcutViewerCondition = `(shortcut.visibility NOT IN ('PRIVATE', 'GROUP') OR shortcut.creator_id = ? OR (shortcut.visibility = 'GROUP' AND shortcut.id IN (
+	SELECT shortcut_group.shortcut_id FROM shortcut_group JOIN user_group_member ON user_group_member.group_id = shortcut_group.group_id WHERE user_group_member.user_id = ?
+)) OR shortcut.id IN (
+	SELECT shortcut_collaborator.shortcut_id FROM shortcut_collaborator WHERE shortcut_collaborator.user_id = ?
+))`
+
+func getShortcutOrderBy(find *store.FindShortcut) string {
+	direction := "ASC"
+	if find.OrderDesc {
+		direction = "DESC"
+	}
+	switch find.OrderBy {
+	case store.ShortcutOrderByCreatedTs:
+		return fmt.Sprintf("created_ts %s, id %s", direction, direction)
+	case store.ShortcutOrderByUpdatedTs:
+		return fmt.Sprintf("updated_ts %s, id %s", direction, direction)
+	case store.ShortcutOrderByName:
+		return fmt.Sprintf("name %s", direction)
+	case store.ShortcutOrderByViewCount:
+		return fmt.Sprintf("(SELECT COALESCE(SUM(count), 0) FROM shortcut_view_stat WHERE shortcut_view_stat.shortcut_id = shortcut.id) %s, id %s", direction, direction)
+	default:
+		return "created_ts DESC, id DESC"
+	}
+}
+
+func filterTags(tags []string) []string {
+	result := []string{}
+	for _, tag := range tags {
+		if tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
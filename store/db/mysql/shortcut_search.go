@@ -0,0 +1,148 @@
+package mysql
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// searchColumns are the searched columns of shortcuts with their rank weights,
+// the same as the bm25 weights of SQLite.
+var searchColumns = []struct {
+	name   string
+	weight float64
+}{
+	{"name", 10},
+	{"aliases", 10},
+	{"title", 5},
+	{"description", 2},
+	{"tag", 5},
+	{"link", 1},
+}
+
+const (
+	// snippetWords is the number of words in search snippets.
+	snippetWords = 16
+)
+
+// SearchShortcuts matches the terms as word prefixes with regular expressions, as the InnoDB full-text index
+// skips short words and stopwords by default. The rank and snippet of the matched shortcuts are computed here.
+func (d *DB) SearchShortcuts(ctx context.Context, search *store.SearchShortcut) ([]*store.ShortcutSearchResult, error) {
+	where, args := []string{}, []any{}
+	for _, term := range search.Terms {
+		where, args = append(where, "LOWER(CONCAT_WS(' ', name, aliases, title, description, tag, link)) REGEXP ?"), append(args, "(^|[^[:alnum:]])"+regexp.QuoteMeta(strings.ToLower(term)))
+	}
+	if v := search.ViewerID; v != nil {
+		where, args = append(where, shortcutViewerCondition), append(args, *v, *v, *v)
+	}
+	if len(where) == 0 {
+		return []*store.ShortcutSearchResult{}, nil
+	}
+	where = append(where, "deleted_ts = 0")
+
+	// The aliases are in another table, so they are aggregated into a column of the shortcuts.
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT id, name, aliases, title, description, tag, link
+		FROM (
+			SELECT
+				shortcut.*,
+				COALESCE((SELECT GROUP_CONCAT(alias SEPARATOR ' ') FROM shortcut_alias WHERE shortcut_id = shortcut.id), '') AS aliases
+			FROM shortcut
+		) shortcut
+		WHERE `+strings.Join(where, " AND "),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutSearchResult{}
+	for rows.Next() {
+		result := &store.ShortcutSearchResult{}
+		values := make([]string, len(searchColumns))
+		if err := rows.Scan(&result.ShortcutID, &values[0], &values[1], &values[2], &values[3], &values[4], &values[5]); err != nil {
+			return nil, err
+		}
+		for i, column := range searchColumns {
+			for _, term := range search.Terms {
+				if matchWordPrefix(values[i], term) {
+					result.Rank += column.weight
+				}
+			}
+		}
+		result.Snippet = buildSnippet(strings.Join(values, " "), search.Terms)
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(list, func(a, b *store.ShortcutSearchResult) int {
+		if a.Rank != b.Rank {
+			if a.Rank > b.Rank {
+				return -1
+			}
+			return 1
+		}
+		return int(b.ShortcutID - a.ShortcutID)
+	})
+	if search.Limit > 0 && len(list) > search.Limit {
+		list = list[:search.Limit]
+	}
+	return list, nil
+}
+
+func splitWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// matchWordPrefix returns true if any word of s starts with the term.
+func matchWordPrefix(s, term string) bool {
+	term = strings.ToLower(term)
+	return slices.ContainsFunc(splitWords(s), func(word string) bool {
+		return strings.HasPrefix(word, term)
+	})
+}
+
+// buildSnippet returns the words of the text around the first matched word, where the matched words are highlighted.
+func buildSnippet(text string, terms []string) string {
+	words := strings.Fields(text)
+	matched := make([]bool, len(words))
+	first := -1
+	for i, word := range words {
+		for _, term := range terms {
+			if matchWordPrefix(word, term) {
+				matched[i] = true
+				break
+			}
+		}
+		if matched[i] && first < 0 {
+			first = i
+		}
+	}
+	start := max(0, min(first-snippetWords/4, len(words)-snippetWords))
+	end := min(len(words), start+snippetWords)
+
+	list := []string{}
+	if start > 0 {
+		list = append(list, "...")
+	}
+	for i := start; i < end; i++ {
+		if matched[i] {
+			list = append(list, store.SearchMatchStart+words[i]+store.SearchMatchEnd)
+		} else {
+			list = append(list, words[i])
+		}
+	}
+	if end < len(words) {
+		list = append(list, "...")
+	}
+	return strings.Join(list, " ")
+}
@@ -0,0 +1,207 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
+	set := []string{"email", "nickname", "password_hash", "role"}
+	args := []any{create.Email, create.Nickname, create.PasswordHash, create.Role}
+	if create.ID != 0 {
+		// Recreates the user with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.ID, create.CreatedTs, create.UpdatedTs)
+	}
+	if create.RowStatus != "" {
+		set, args = append(set, "row_status"), append(args, create.RowStatus)
+	}
+
+	stmt := "INSERT INTO `user` (" + strings.Join(set, ", ") + ") VALUES (" + placeholders(len(args)) + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	if err := d.db.QueryRowContext(ctx, "SELECT created_ts, updated_ts, row_status FROM `user` WHERE id = ?", create.ID).Scan(
+		&create.CreatedTs,
+		&create.UpdatedTs,
+		&create.RowStatus,
+	); err != nil {
+		return nil, err
+	}
+
+	user := create
+	return user, nil
+}
+
+func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.User, error) {
+	set, args := []string{}, []any{}
+	if v := update.RowStatus; v != nil {
+		set, args = append(set, "row_status = ?"), append(args, *v)
+	}
+	if v := update.Email; v != nil {
+		set, args = append(set, "email = ?"), append(args, *v)
+	}
+	if v := update.Nickname; v != nil {
+		set, args = append(set, "nickname = ?"), append(args, *v)
+	}
+	if v := update.PasswordHash; v != nil {
+		set, args = append(set, "password_hash = ?"), append(args, *v)
+	}
+	if v := update.Role; v != nil {
+		set, args = append(set, "role = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if update.TransferTo != nil {
+		if err := transferUserResources(ctx, tx, update.ID, *update.TransferTo); err != nil {
+			return nil, err
+		}
+	}
+	stmt := "UPDATE `user` SET " + strings.Join(set, ", ") + " WHERE id = ?"
+	args = append(args, update.ID)
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListUsers(ctx, &store.FindUser{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("user not found")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
+	if v := find.Email; v != nil {
+		where, args = append(where, "email = ?"), append(args, *v)
+	}
+	if v := find.Nickname; v != nil {
+		where, args = append(where, "nickname = ?"), append(args, *v)
+	}
+	if v := find.Role; v != nil {
+		where, args = append(where, "role = ?"), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			row_status,
+			email,
+			nickname,
+			password_hash,
+			role
+		FROM ` + "`user`" + `
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY updated_ts DESC, created_ts DESC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.User, 0)
+	for rows.Next() {
+		user := &store.User{}
+		if err := rows.Scan(
+			&user.ID,
+			&user.CreatedTs,
+			&user.UpdatedTs,
+			&user.RowStatus,
+			&user.Email,
+			&user.Nickname,
+			&user.PasswordHash,
+			&user.Role,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if delete.TransferTo != nil {
+		if err := transferUserResources(ctx, tx, delete.ID, *delete.TransferTo); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `user` WHERE id = ?", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) TransferUserResources(ctx context.Context, transfer *store.TransferUserResources) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := transferUserResources(ctx, tx, transfer.FromUserID, transfer.ToUserID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// transferUserResources reassigns the shortcuts and collections of a user to another user,
+// who does not need to stay a collaborator of them.
+func transferUserResources(ctx context.Context, tx *sql.Tx, fromUserID, toUserID int32) error {
+	if _, err := tx.ExecContext(ctx, "UPDATE shortcut SET creator_id = ? WHERE creator_id = ?", toUserID, fromUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE collection SET creator_id = ? WHERE creator_id = ?", toUserID, fromUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM shortcut_collaborator WHERE user_id = ? AND shortcut_id IN (SELECT id FROM shortcut WHERE creator_id = ?)", toUserID, toUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM collection_collaborator WHERE user_id = ? AND collection_id IN (SELECT id FROM collection WHERE creator_id = ?)", toUserID, toUserID); err != nil {
+		return err
+	}
+	return nil
+}
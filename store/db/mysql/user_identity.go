@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateUserIdentity(ctx context.Context, create *store.UserIdentity) (*store.UserIdentity, error) {
+	set := []string{"user_id", "provider_id", "subject"}
+	args := []any{create.UserID, create.ProviderID, create.Subject}
+	if create.CreatedTs != 0 {
+		// Recreates the identity with its timestamp, e.g. when importing an archive.
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
+
+	stmt := "INSERT INTO user_identity (" + strings.Join(set, ", ") + ") VALUES (" + placeholders(len(args)) + ")"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	if err := d.db.QueryRowContext(ctx, "SELECT created_ts FROM user_identity WHERE provider_id = ? AND subject = ?", create.ProviderID, create.Subject).Scan(&create.CreatedTs); err != nil {
+		return nil, err
+	}
+	identity := create
+	return identity, nil
+}
+
+func (d *DB) ListUserIdentities(ctx context.Context, find *store.FindUserIdentity) ([]*store.UserIdentity, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+	if v := find.ProviderID; v != nil {
+		where, args = append(where, "provider_id = ?"), append(args, *v)
+	}
+	if v := find.Subject; v != nil {
+		where, args = append(where, "subject = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			user_id,
+			provider_id,
+			subject,
+			created_ts
+		FROM user_identity
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, provider_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.UserIdentity, 0)
+	for rows.Next() {
+		identity := &store.UserIdentity{}
+		if err := rows.Scan(
+			&identity.UserID,
+			&identity.ProviderID,
+			&identity.Subject,
+			&identity.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, identity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
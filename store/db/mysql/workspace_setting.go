@@ -0,0 +1,156 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
+	stmt := `
+		INSERT INTO workspace_setting (
+			` + "`key`" + `,
+			value
+		)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE
+			value = VALUES(value)
+	`
+	var valueString string
+	if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY {
+		valueString = upsert.GetLicenseKey()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION {
+		valueString = upsert.GetSecretSession()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSAPCE_SETTING_ENABLE_SIGNUP {
+		valueString = strconv.FormatBool(upsert.GetEnableSignup())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_STYLE {
+		valueString = upsert.GetCustomStyle()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_SCRIPT {
+		valueString = upsert.GetCustomScript()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP {
+		valueBytes, err := protojson.Marshal(upsert.GetAutoBackup())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INSTANCE_URL {
+		valueString = upsert.GetInstanceUrl()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY {
+		valueString = upsert.GetDefaultVisibility().String()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS {
+		valueString = strconv.Itoa(int(upsert.GetTrashRetentionDays()))
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS {
+		valueBytes, err := protojson.Marshal(upsert.GetIdentityProviders())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP {
+		valueBytes, err := protojson.Marshal(upsert.GetLdap())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else {
+		return nil, errors.New("invalid workspace setting key")
+	}
+
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.Key.String(), valueString); err != nil {
+		return nil, err
+	}
+
+	workspaceSetting := upsert
+	return workspaceSetting, nil
+}
+
+func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*storepb.WorkspaceSetting, error) {
+	where, args := []string{"1 = 1"}, []interface{}{}
+
+	if find.Key != storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED {
+		where, args = append(where, "`key` = ?"), append(args, find.Key.String())
+	}
+
+	query := `
+		SELECT
+			` + "`key`" + `,
+			value
+		FROM workspace_setting
+		WHERE ` + strings.Join(where, " AND ")
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	list := []*storepb.WorkspaceSetting{}
+	for rows.Next() {
+		workspaceSetting := &storepb.WorkspaceSetting{}
+		var keyString, valueString string
+		if err := rows.Scan(
+			&keyString,
+			&valueString,
+		); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Key = storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[keyString])
+		if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_LicenseKey{LicenseKey: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_SecretSession{SecretSession: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSAPCE_SETTING_ENABLE_SIGNUP {
+			enableSignup, err := strconv.ParseBool(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_EnableSignup{EnableSignup: enableSignup}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_STYLE {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_CustomStyle{CustomStyle: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_SCRIPT {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_CustomScript{CustomScript: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP {
+			autoBackupSetting := &storepb.AutoBackupWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), autoBackupSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_AutoBackup{AutoBackup: autoBackupSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INSTANCE_URL {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_InstanceUrl{InstanceUrl: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_DefaultVisibility{DefaultVisibility: storepb.Visibility(storepb.Visibility_value[valueString])}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS {
+			trashRetentionDays, err := strconv.Atoi(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_TrashRetentionDays{TrashRetentionDays: int32(trashRetentionDays)}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS {
+			identityProvidersSetting := &storepb.IdentityProvidersWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), identityProvidersSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_IdentityProviders{IdentityProviders: identityProvidersSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP {
+			ldapSetting := &storepb.LDAPWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), ldapSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Ldap{Ldap: ldapSetting}
+		} else {
+			continue
+		}
+		list = append(list, workspaceSetting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -9,30 +10,60 @@ import (
 )
 
 func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	activity, err := createActivity(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// createActivity creates the activity in the transaction.
+func createActivity(ctx context.Context, tx *sql.Tx, create *store.Activity) (*store.Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	explicitID := create.ID != 0
+	if explicitID {
+		// Recreates the activity with its id and timestamp, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts"), append(args, create.ID, create.CreatedTs)
+	}
+
 	stmt := `
-		INSERT INTO activity (
-			creator_id,
-			type,
-			level,
-			payload
-		)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO activity (` + strings.Join(set, ", ") + `)
+		VALUES (` + placeholders(len(args)) + `)
 		RETURNING id, created_ts
 	`
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.CreatorID,
-		create.Type.String(),
-		create.Level.String(),
-		create.Payload,
-	).Scan(
+	if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
 		return nil, err
 	}
+	if explicitID {
+		if err := advanceIDSequence(ctx, tx, "activity"); err != nil {
+			return nil, err
+		}
+	}
+	// Keep the shortcut view stats up to date with the view activities.
+	if create.Type == store.ActivityShortcutView {
+		stat, err := store.ConvertActivityToShortcutViewStat(create)
+		if err != nil {
+			return nil, err
+		}
+		if err := increaseShortcutViewStat(ctx, tx, stat); err != nil {
+			return nil, err
+		}
+	}
 
-	activity := create
-	return activity, nil
+	return create, nil
 }
 
 func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
@@ -46,6 +77,12 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.PayloadShortcutID != nil {
 		where, args = append(where, fmt.Sprintf("CAST(payload::JSON->>'shortcutId' AS INTEGER) = %s", placeholder(len(args)+1))), append(args, *find.PayloadShortcutID)
 	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *find.CreatedTsBefore)
+	}
 
 	query := `
 		SELECT
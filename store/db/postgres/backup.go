@@ -0,0 +1,307 @@
+package postgres
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/version"
+)
+
+const (
+	// dumpHeader is the first line of the dumps.
+	dumpHeader = "-- Slash PostgreSQL dump"
+	// migrationHistoryTable is excluded from restoring, as the schema is not restored.
+	migrationHistoryTable = "migration_history"
+	// schemaVersionPrefix is the prefix of the second line of the dumps, followed by the schema version of the data.
+	schemaVersionPrefix = "-- Schema version: "
+)
+
+// Backup writes a logical dump of the data of all tables to the file at path.
+// The dump is read in a single repeatable read transaction, so it is a consistent snapshot.
+// Every statement of the dump is on its own line, and the rows are inserted in the order of
+// the foreign keys, so the dump can be restored into an empty database with the same schema.
+func (d *DB) Backup(ctx context.Context, path string) error {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tables, err := listTablesInDependencyOrder(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list tables")
+	}
+	schemaVersion, err := getSchemaVersion(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get schema version")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to create dump file")
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+	if _, err := writer.WriteString(dumpHeader + "\n" + schemaVersionPrefix + schemaVersion + "\n"); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := dumpTable(ctx, tx, writer, table); err != nil {
+			return errors.Wrapf(err, "failed to dump table %s", table)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Restore replaces the data of all tables with the data of the dump at path, which is created by Backup.
+// The schema is left as is, so the dump has to be of the same schema version as the database.
+// The dump is replayed in a single transaction, which is rolled back on any failure.
+func (d *DB) Restore(ctx context.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open dump file")
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	header, err := reader.ReadString('\n')
+	if err != nil || strings.TrimSpace(header) != dumpHeader {
+		return errors.New("invalid dump file")
+	}
+	versionLine, err := reader.ReadString('\n')
+	dumpSchemaVersion, ok := strings.CutPrefix(versionLine, schemaVersionPrefix)
+	if err != nil || !ok {
+		return errors.New("dump file has no schema version")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	schemaVersion, err := getSchemaVersion(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get schema version")
+	}
+	if dumpSchemaVersion = strings.TrimSpace(dumpSchemaVersion); dumpSchemaVersion != schemaVersion {
+		return errors.Errorf("dump of schema version %s does not match the database schema version %s", dumpSchemaVersion, schemaVersion)
+	}
+
+	tables, err := listTablesInDependencyOrder(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list tables")
+	}
+	truncatedTables := []string{}
+	for _, table := range tables {
+		if table != migrationHistoryTable {
+			truncatedTables = append(truncatedTables, quoteIdentifier(table))
+		}
+	}
+	if len(truncatedTables) > 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("TRUNCATE %s RESTART IDENTITY", strings.Join(truncatedTables, ", "))); err != nil {
+			return errors.Wrap(err, "failed to truncate tables")
+		}
+	}
+
+	skippedPrefix := fmt.Sprintf("INSERT INTO %s ", quoteIdentifier(migrationHistoryTable))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return errors.Wrap(err, "failed to read dump file")
+		}
+		stmt := strings.TrimSpace(line)
+		if stmt != "" && !strings.HasPrefix(stmt, "--") && !strings.HasPrefix(stmt, skippedPrefix) {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return errors.Wrap(err, "failed to replay dump")
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return tx.Commit()
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, writer *bufio.Writer, table string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT column_name, COALESCE(column_default, '')
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER'
+		ORDER BY ordinal_position`,
+		table,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns, serialColumns := []string{}, []string{}
+	for rows.Next() {
+		var column, columnDefault string
+		if err := rows.Scan(&column, &columnDefault); err != nil {
+			return err
+		}
+		columns = append(columns, quoteIdentifier(column))
+		if strings.HasPrefix(columnDefault, "nextval(") {
+			serialColumns = append(serialColumns, column)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Every row is inserted from its JSON representation, which keeps the values of all types intact.
+	columnList := strings.Join(columns, ", ")
+	dataRows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT row_to_json(t)::TEXT FROM %s t`, quoteIdentifier(table)))
+	if err != nil {
+		return err
+	}
+	defer dataRows.Close()
+	for dataRows.Next() {
+		var data string
+		if err := dataRows.Scan(&data); err != nil {
+			return err
+		}
+		stmt := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM json_populate_record(NULL::%s, %s);\n", quoteIdentifier(table), columnList, columnList, quoteIdentifier(table), quoteLiteral(data))
+		if _, err := writer.WriteString(stmt); err != nil {
+			return err
+		}
+	}
+	if err := dataRows.Err(); err != nil {
+		return err
+	}
+
+	// Move the sequences past the restored rows.
+	for _, column := range serialColumns {
+		stmt := fmt.Sprintf("SELECT setval(pg_get_serial_sequence(%s, %s), COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false);\n", quoteLiteral(quoteIdentifier(table)), quoteLiteral(column), quoteIdentifier(column), quoteIdentifier(table))
+		if _, err := writer.WriteString(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTablesInDependencyOrder returns the tables of the current schema, where the tables
+// referenced by foreign keys come before the tables referencing them.
+func listTablesInDependencyOrder(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'
+		ORDER BY table_name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	referenceRows, err := tx.QueryContext(ctx, `
+		SELECT source.relname, target.relname
+		FROM pg_constraint
+		JOIN pg_class source ON source.oid = pg_constraint.conrelid
+		JOIN pg_class target ON target.oid = pg_constraint.confrelid
+		JOIN pg_namespace ON pg_namespace.oid = source.relnamespace
+		WHERE pg_constraint.contype = 'f' AND pg_namespace.nspname = current_schema()`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer referenceRows.Close()
+	references := map[string][]string{}
+	for referenceRows.Next() {
+		var source, target string
+		if err := referenceRows.Scan(&source, &target); err != nil {
+			return nil, err
+		}
+		if source != target {
+			references[source] = append(references[source], target)
+		}
+	}
+	if err := referenceRows.Err(); err != nil {
+		return nil, err
+	}
+
+	sorted, visited := []string{}, map[string]bool{}
+	for len(sorted) < len(tables) {
+		progressed := false
+		for _, table := range tables {
+			if visited[table] {
+				continue
+			}
+			ready := true
+			for _, target := range references[table] {
+				if !visited[target] && slices.Contains(tables, target) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				visited[table] = true
+				sorted = append(sorted, table)
+				progressed = true
+			}
+		}
+		if !progressed {
+			return nil, errors.New("found a cycle in the foreign keys")
+		}
+	}
+	return sorted, nil
+}
+
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+func quoteLiteral(literal string) string {
+	return `'` + strings.ReplaceAll(literal, `'`, `''`) + `'`
+}
+
+// getSchemaVersion returns the latest version of the migration history, which is the version of the schema.
+func getSchemaVersion(ctx context.Context, tx *sql.Tx) (string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT version FROM "+quoteIdentifier(migrationHistoryTable))
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	versions := []string{}
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return "", err
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	sort.Sort(version.SortVersion(versions))
+	return versions[len(versions)-1], nil
+}
@@ -14,8 +14,13 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String()}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "deleted_ts", "group_ids"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), create.DeletedTs, pq.Array(append([]int32{}, create.GroupIds...))}
+	explicitID := create.Id != 0
+	if explicitID {
+		// Recreates the collection with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.Id, create.CreatedTs, create.UpdatedTs)
+	}
 
 	stmt := `
 		INSERT INTO collection (` + strings.Join(set, ", ") + `)
@@ -29,6 +34,11 @@ func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (
 	); err != nil {
 		return nil, err
 	}
+	if explicitID {
+		if err := advanceIDSequence(ctx, d.db, "collection"); err != nil {
+			return nil, err
+		}
+	}
 	collection := create
 	return collection, nil
 }
@@ -50,6 +60,12 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, update.Visibility.String())
 	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = "+placeholder(len(args)+1)), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		set, args = append(set, "group_ids = "+placeholder(len(args)+1)), append(args, pq.Array(append([]int32{}, update.GroupIDs...)))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -58,11 +74,11 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		UPDATE collection
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, deleted_ts, group_ids
 	`
 	args = append(args, update.ID)
 	collection := &storepb.Collection{}
-	var shortcutIDs []sql.NullInt32
+	var shortcutIDs, groupIDs []sql.NullInt32
 	var visibility string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
@@ -74,15 +90,15 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		pq.Array(&shortcutIDs),
 		&visibility,
+		&collection.DeletedTs,
+		pq.Array(&groupIDs),
 	); err != nil {
 		return nil, err
 	}
 
-	collection.ShortcutIds = []int32{}
-	for _, id := range shortcutIDs {
-		if id.Valid {
-			collection.ShortcutIds = append(collection.ShortcutIds, id.Int32)
-		}
+	collection.ShortcutIds = convertIDArray(shortcutIDs)
+	if len(groupIDs) > 0 {
+		collection.GroupIds = convertIDArray(groupIDs)
 	}
 	collection.Visibility = convertVisibilityStringToStorepb(visibility)
 	return collection, nil
@@ -106,6 +122,11 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	if find.OnlyDeleted {
+		where = append(where, "deleted_ts > 0")
+	} else if !find.IncludeDeleted {
+		where = append(where, "deleted_ts = 0")
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -117,7 +138,9 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			deleted_ts,
+			group_ids
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -131,7 +154,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	list := make([]*storepb.Collection, 0)
 	for rows.Next() {
 		collection := &storepb.Collection{}
-		var shortcutIDs []sql.NullInt32
+		var shortcutIDs, groupIDs []sql.NullInt32
 		var visibility string
 		if err := rows.Scan(
 			&collection.Id,
@@ -143,15 +166,15 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			pq.Array(&shortcutIDs),
 			&visibility,
+			&collection.DeletedTs,
+			pq.Array(&groupIDs),
 		); err != nil {
 			return nil, err
 		}
 
-		collection.ShortcutIds = []int32{}
-		for _, id := range shortcutIDs {
-			if id.Valid {
-				collection.ShortcutIds = append(collection.ShortcutIds, id.Int32)
-			}
+		collection.ShortcutIds = convertIDArray(shortcutIDs)
+		if len(groupIDs) > 0 {
+			collection.GroupIds = convertIDArray(groupIDs)
 		}
 		collection.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
 		list = append(list, collection)
@@ -170,3 +193,13 @@ func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollectio
 
 	return nil
 }
+
+func convertIDArray(ids []sql.NullInt32) []int32 {
+	list := []int32{}
+	for _, id := range ids {
+		if id.Valid {
+			list = append(list, id.Int32)
+		}
+	}
+	return list
+}
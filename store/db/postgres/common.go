@@ -1,8 +1,12 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 func placeholder(n int) string {
@@ -16,3 +20,28 @@ func placeholders(n int) string {
 	}
 	return strings.Join(list, ", ")
 }
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// advanceIDSequence moves the id sequence of the table past the rows inserted with explicit ids,
+// so that the next inserted rows do not reuse their ids.
+func advanceIDSequence(ctx context.Context, db execer, table string) error {
+	stmt := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%s', 'id'), (SELECT MAX(id) FROM %s))`, table, table)
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return errors.Wrapf(err, "failed to advance the id sequence of %s", table)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateGroup(ctx context.Context, create *store.Group) (*store.Group, error) {
+	set := []string{"name", "description"}
+	args := []any{create.Name, create.Description}
+	explicitID := create.ID != 0
+	if explicitID {
+		// Recreates the group with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.ID, create.CreatedTs, create.UpdatedTs)
+	}
+
+	stmt := `
+		INSERT INTO user_group (` + strings.Join(set, ", ") + `)
+		VALUES (` + placeholders(len(args)) + `)
+		RETURNING id, created_ts, updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	if explicitID {
+		if err := advanceIDSequence(ctx, d.db, "user_group"); err != nil {
+			return nil, err
+		}
+	}
+
+	group := create
+	return group, nil
+}
+
+func (d *DB) UpdateGroup(ctx context.Context, update *store.UpdateGroup) (*store.Group, error) {
+	set, args := []string{}, []any{}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	stmt := `
+		UPDATE user_group
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)+1) + `
+		RETURNING id, created_ts, updated_ts, name, description
+	`
+	args = append(args, update.ID)
+	group := &store.Group{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&group.ID,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+		&group.Name,
+		&group.Description,
+	); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (d *DB) ListGroups(ctx context.Context, find *store.FindGroup) ([]*store.Group, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.IDList; v != nil {
+		list := []string{}
+		for _, id := range v {
+			list, args = append(list, placeholder(len(args)+1)), append(args, id)
+		}
+		if len(list) == 0 {
+			where = append(where, "1 = 0")
+		} else {
+			where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ", ")))
+		}
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "id IN (SELECT group_id FROM user_group_member WHERE user_id = "+placeholder(len(args)+1)+")"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			name,
+			description
+		FROM user_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY name ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Group, 0)
+	for rows.Next() {
+		group := &store.Group{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+			&group.Name,
+			&group.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteGroup deletes the group, while the foreign keys delete its members and shares.
+func (d *DB) DeleteGroup(ctx context.Context, delete *store.DeleteGroup) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM user_group WHERE id = $1", delete.ID)
+	return err
+}
+
+func (d *DB) UpsertGroupMember(ctx context.Context, upsert *store.GroupMember) (*store.GroupMember, error) {
+	stmt := `
+		INSERT INTO user_group_member (
+			group_id, user_id
+		)
+		VALUES ($1, $2)
+		ON CONFLICT(group_id, user_id) DO UPDATE
+		SET group_id = EXCLUDED.group_id
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.GroupID, upsert.UserID).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	member := upsert
+	return member, nil
+}
+
+func (d *DB) ListGroupMembers(ctx context.Context, find *store.FindGroupMember) ([]*store.GroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			group_id,
+			user_id,
+			created_ts
+		FROM user_group_member
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.GroupMember, 0)
+	for rows.Next() {
+		member := &store.GroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteGroupMember(ctx context.Context, delete *store.DeleteGroupMember) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM user_group_member WHERE group_id = $1 AND user_id = $2", delete.GroupID, delete.UserID)
+	return err
+}
@@ -43,13 +43,34 @@ CREATE TABLE shortcut (
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  fallback_link TEXT NOT NULL DEFAULT '',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') ||
+    setweight(to_tsvector('simple', title), 'B') ||
+    setweight(to_tsvector('simple', tag), 'B') ||
+    setweight(to_tsvector('simple', description), 'C') ||
+    setweight(to_tsvector('simple', link), 'D')
+  ) STORED
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE INDEX idx_shortcut_search_vector ON shortcut USING GIN (search_vector);
+
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  alias TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
 -- activity
 CREATE TABLE activity (
   id SERIAL PRIMARY KEY,
@@ -60,6 +81,17 @@ CREATE TABLE activity (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+-- shortcut_view_stat
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INTEGER NOT NULL,
+  day_ts BIGINT NOT NULL,
+  referer TEXT NOT NULL DEFAULT '',
+  device TEXT NOT NULL DEFAULT '',
+  browser TEXT NOT NULL DEFAULT '',
+  count INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(shortcut_id, day_ts, referer, device, browser)
+);
+
 -- collection
 CREATE TABLE collection (
   id SERIAL PRIMARY KEY,
@@ -70,7 +102,70 @@ CREATE TABLE collection (
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER ARRAY NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids INTEGER ARRAY NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- shortcut_group
+CREATE TABLE shortcut_group (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  UNIQUE(shortcut_id, group_id)
+);
+
+CREATE INDEX idx_shortcut_group_group_id ON shortcut_group(group_id);
+
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_collaborator_user_id ON shortcut_collaborator(user_id);
+
+-- collection_collaborator
+CREATE TABLE collection_collaborator (
+  collection_id INTEGER REFERENCES collection(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(collection_id, user_id)
+);
+
+CREATE INDEX idx_collection_collaborator_user_id ON collection_collaborator(user_id);
+
+-- user_identity
+CREATE TABLE user_identity (
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  provider_id TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(provider_id, subject)
+);
+
+CREATE INDEX idx_user_identity_user_id ON user_identity(user_id);
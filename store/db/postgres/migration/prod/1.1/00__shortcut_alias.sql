@@ -0,0 +1,7 @@
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  alias TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
@@ -0,0 +1,10 @@
+-- shortcut.search_vector
+ALTER TABLE shortcut ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', name), 'A') ||
+  setweight(to_tsvector('simple', title), 'B') ||
+  setweight(to_tsvector('simple', tag), 'B') ||
+  setweight(to_tsvector('simple', description), 'C') ||
+  setweight(to_tsvector('simple', link), 'D')
+) STORED;
+
+CREATE INDEX idx_shortcut_search_vector ON shortcut USING GIN (search_vector);
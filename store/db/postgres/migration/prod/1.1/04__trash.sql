@@ -0,0 +1,3 @@
+ALTER TABLE shortcut ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE collection ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
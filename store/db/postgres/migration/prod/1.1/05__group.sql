@@ -0,0 +1,37 @@
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- shortcut_group
+CREATE TABLE shortcut_group (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  UNIQUE(shortcut_id, group_id)
+);
+
+CREATE INDEX idx_shortcut_group_group_id ON shortcut_group(group_id);
+
+ALTER TABLE shortcut DROP CONSTRAINT shortcut_visibility_check;
+
+ALTER TABLE shortcut ADD CONSTRAINT shortcut_visibility_check CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP'));
+
+ALTER TABLE collection DROP CONSTRAINT collection_visibility_check;
+
+ALTER TABLE collection ADD CONSTRAINT collection_visibility_check CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP'));
+
+ALTER TABLE collection ADD COLUMN group_ids INTEGER ARRAY NOT NULL DEFAULT '{}';
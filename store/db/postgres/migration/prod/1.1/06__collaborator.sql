@@ -0,0 +1,21 @@
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_collaborator_user_id ON shortcut_collaborator(user_id);
+
+-- collection_collaborator
+CREATE TABLE collection_collaborator (
+  collection_id INTEGER REFERENCES collection(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(collection_id, user_id)
+);
+
+CREATE INDEX idx_collection_collaborator_user_id ON collection_collaborator(user_id);
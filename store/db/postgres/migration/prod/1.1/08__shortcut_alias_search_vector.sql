@@ -0,0 +1,2 @@
+-- shortcut_alias search vector
+CREATE INDEX idx_shortcut_alias_search_vector ON shortcut_alias USING GIN (to_tsvector('simple', alias));
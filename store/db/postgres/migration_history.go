@@ -7,6 +7,10 @@ import (
 )
 
 func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
+	return upsertMigrationHistory(ctx, d.db, upsert)
+}
+
+func upsertMigrationHistory(ctx context.Context, db queryRower, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
 	stmt := `
 		INSERT INTO migration_history (
 			version
@@ -18,7 +22,7 @@ func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMig
 		RETURNING version, created_ts
 	`
 	var migrationHistory store.MigrationHistory
-	if err := d.db.QueryRowContext(ctx, stmt, upsert.Version).Scan(
+	if err := db.QueryRowContext(ctx, stmt, upsert.Version).Scan(
 		&migrationHistory.Version,
 		&migrationHistory.CreatedTs,
 	); err != nil {
@@ -7,7 +7,6 @@ import (
 	"io/fs"
 	"regexp"
 	"sort"
-	"strings"
 
 	"github.com/pkg/errors"
 
@@ -22,72 +21,55 @@ const (
 //go:embed migration
 var migrationFS embed.FS
 
+// Migrate applies the pending migrations to the database, each one in its own transaction.
 func (d *DB) Migrate(ctx context.Context) error {
-	if d.profile.IsDev() {
-		return d.nonProdMigrate(ctx)
-	}
-
-	return d.prodMigrate(ctx)
-}
-
-func (d *DB) nonProdMigrate(ctx context.Context) error {
-	rows, err := d.db.QueryContext(ctx, "SELECT tablename FROM pg_catalog.pg_tables WHERE schemaname != 'pg_catalog' AND schemaname != 'information_schema';")
+	migrations, err := d.ListPendingMigrations(ctx)
 	if err != nil {
-		return errors.Errorf("failed to query database tables: %s", err)
-	}
-	if rows.Err() != nil {
-		return errors.Errorf("failed to query database tables: %s", err)
-	}
-	defer rows.Close()
-
-	var tables []string
-	for rows.Next() {
-		var table string
-		err := rows.Scan(&table)
-		if err != nil {
-			return errors.Errorf("failed to scan table name: %s", err)
-		}
-		tables = append(tables, table)
+		return errors.Wrap(err, "failed to list pending migrations")
 	}
-
-	if len(tables) != 0 {
+	if len(migrations) == 0 {
 		return nil
 	}
 
-	buf, err := migrationFS.ReadFile("migration/dev/" + latestSchemaFileName)
-	if err != nil {
-		return errors.Errorf("failed to read latest schema file: %s", err)
-	}
-
-	stmt := string(buf)
-	if _, err := d.db.ExecContext(ctx, stmt); err != nil {
-		return errors.Errorf("failed to exec SQL %s: %s", stmt, err)
+	println("start migrate")
+	for _, migration := range migrations {
+		println("applying migration for", migration.Version)
+		if err := d.applyMigration(ctx, migration); err != nil {
+			return errors.Wrapf(err, "failed to apply migration for %s", migration.Version)
+		}
 	}
-
+	println("end migrate")
 	return nil
 }
 
-func (d *DB) prodMigrate(ctx context.Context) error {
+// ListPendingMigrations returns the migrations which are applied by the next Migrate.
+// A new database gets the latest schema, and an existing one the migrations of the minor versions
+// between the latest migration history version and the current version.
+func (d *DB) ListPendingMigrations(ctx context.Context) ([]*store.PendingMigration, error) {
 	currentVersion := version.GetCurrentVersion(d.profile.Mode)
-	migrationHistoryList, err := d.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
-	// If there is no migration history, we should apply the latest schema.
-	if err != nil || len(migrationHistoryList) == 0 {
-		latestSchemaBytes, err := migrationFS.ReadFile("migration/prod/" + latestSchemaFileName)
-		if err != nil {
-			return errors.Errorf("failed to read latest schema file: %s", err)
-		}
-
-		latestSchema := string(latestSchemaBytes)
-		if _, err := d.db.ExecContext(ctx, latestSchema); err != nil {
-			return errors.Errorf("failed to exec SQL %s: %s", latestSchema, err)
+	if d.profile.IsDev() {
+		var count int
+		if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM pg_catalog.pg_tables WHERE schemaname != 'pg_catalog' AND schemaname != 'information_schema'").Scan(&count); err != nil {
+			return nil, errors.Wrap(err, "failed to query database tables")
 		}
-		// After applying the latest schema, we should insert the latest version to migration_history.
-		if _, err := d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{
-			Version: currentVersion,
-		}); err != nil {
-			return errors.Wrap(err, "failed to upsert migration history")
+		if count != 0 {
+			return []*store.PendingMigration{}, nil
 		}
-		return nil
+		return []*store.PendingMigration{
+			{Version: currentVersion, Files: []string{"migration/dev/" + latestSchemaFileName}},
+		}, nil
+	}
+
+	migrationHistoryList, err := d.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
+	if err != nil {
+		// New databases do not have the migration_history table yet.
+		migrationHistoryList = nil
+	}
+	// If there is no migration history, we should apply the latest schema.
+	if len(migrationHistoryList) == 0 {
+		return []*store.PendingMigration{
+			{Version: currentVersion, Files: []string{"migration/prod/" + latestSchemaFileName}},
+		}, nil
 	}
 
 	migrationHistoryVersionList := []string{}
@@ -98,53 +80,49 @@ func (d *DB) prodMigrate(ctx context.Context) error {
 	latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
 	// If the latest migration history version is greater than or equal to the current version, we will not apply any migration.
 	if !version.IsVersionGreaterThan(version.GetSchemaVersion(currentVersion), latestMigrationHistoryVersion) {
-		return nil
+		return []*store.PendingMigration{}, nil
 	}
 
-	println("start migrate")
+	migrations := []*store.PendingMigration{}
 	for _, minorVersion := range getMinorVersionList() {
 		normalizedVersion := minorVersion + ".0"
 		if version.IsVersionGreaterThan(normalizedVersion, latestMigrationHistoryVersion) && version.IsVersionGreaterOrEqualThan(currentVersion, normalizedVersion) {
-			println("applying migration for", normalizedVersion)
-			if err := d.applyMigrationForMinorVersion(ctx, minorVersion); err != nil {
-				return errors.Wrap(err, "failed to apply minor version migration")
+			filenames, err := fs.Glob(migrationFS, fmt.Sprintf("migration/prod/%s/*.sql", minorVersion))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read ddl files")
 			}
+			sort.Strings(filenames)
+			migrations = append(migrations, &store.PendingMigration{Version: normalizedVersion, Files: filenames})
 		}
 	}
-	println("end migrate")
-	return nil
+	return migrations, nil
 }
 
-func (d *DB) applyMigrationForMinorVersion(ctx context.Context, minorVersion string) error {
-	filenames, err := fs.Glob(migrationFS, fmt.Sprintf("migration/prod/%s/*.sql", minorVersion))
+// applyMigration runs the files of the migration and records its version in a single transaction,
+// so a failed migration leaves the schema as it was.
+func (d *DB) applyMigration(ctx context.Context, migration *store.PendingMigration) error {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.Wrap(err, "failed to read ddl files")
+		return err
 	}
+	defer tx.Rollback()
 
-	sort.Strings(filenames)
 	// Loop over all migration files and execute them in order.
-	for _, filename := range filenames {
+	for _, filename := range migration.Files {
 		buf, err := migrationFS.ReadFile(filename)
 		if err != nil {
-			return errors.Wrapf(err, "failed to read minor version migration file, filename=%s", filename)
+			return errors.Wrapf(err, "failed to read migration file, filename=%s", filename)
 		}
-		for _, stmt := range strings.Split(string(buf), ";") {
-			if strings.TrimSpace(stmt) == "" {
-				continue
-			}
-			if _, err := d.db.ExecContext(ctx, stmt); err != nil {
-				return errors.Wrapf(err, "migrate error: %s", stmt)
-			}
+		if _, err := tx.ExecContext(ctx, string(buf)); err != nil {
+			return errors.Wrapf(err, "migrate error: %s", filename)
 		}
 	}
 
 	// Upsert the newest version to migration_history.
-	version := minorVersion + ".0"
-	if _, err = d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{Version: version}); err != nil {
-		return errors.Wrapf(err, "failed to upsert migration history with version: %s", version)
+	if _, err := upsertMigrationHistory(ctx, tx, &store.UpsertMigrationHistory{Version: migration.Version}); err != nil {
+		return errors.Wrapf(err, "failed to upsert migration history with version: %s", migration.Version)
 	}
-
-	return nil
+	return tx.Commit()
 }
 
 // minorDirRegexp is a regular expression for minor version directory.
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) SearchShortcuts(ctx context.Context, search *store.SearchShortcut) ([]*store.ShortcutSearchResult, error) {
+	// Every term is a prefix query, and all of them are required to match.
+	terms := []string{}
+	for _, term := range search.Terms {
+		terms = append(terms, term+":*")
+	}
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=1, MaxWords=16, MinWords=4, FragmentDelimiter=...", store.SearchMatchStart, store.SearchMatchEnd)
+	// The shortcuts match either by their search vector or by one of their aliases, which are in another table,
+	// so that both lookups use their indexes. The aliases are ranked along with the search vector.
+	where, args := []string{`shortcut.id IN (
+		SELECT id FROM shortcut WHERE search_vector @@ to_tsquery('simple', $1)
+		UNION
+		SELECT shortcut_id FROM shortcut_alias WHERE to_tsvector('simple', alias) @@ to_tsquery('simple', $1)
+	)`, "deleted_ts = 0"}, []any{strings.Join(terms, " & "), headlineOptions}
+	if v := search.ViewerID; v != nil {
+		where, args = append(where, fmt.Sprintf(shortcutViewerCondition, placeholder(len(args)+1))), append(args, *v)
+	}
+	limitClause := ""
+	if search.Limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", search.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			ts_rank(search_vector || setweight(to_tsvector('simple', aliases), 'A'), query) AS rank,
+			ts_headline('simple', concat_ws(' ', name, aliases, title, description, tag, link), query, $2)
+		FROM shortcut
+		CROSS JOIN to_tsquery('simple', $1) query
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(string_agg(alias, ' '), '') AS aliases FROM shortcut_alias WHERE shortcut_id = shortcut.id
+		) shortcut_aliases
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY rank DESC, id DESC`+limitClause,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutSearchResult{}
+	for rows.Next() {
+		result := &store.ShortcutSearchResult{}
+		if err := rows.Scan(&result.ShortcutID, &result.Rank, &result.Snippet); err != nil {
+			return nil, err
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func increaseShortcutViewStat(ctx context.Context, db execer, increase *store.ShortcutViewStat) error {
+	stmt := `
+		INSERT INTO shortcut_view_stat (
+			shortcut_id, day_ts, referer, device, browser, count
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT(shortcut_id, day_ts, referer, device, browser) DO UPDATE
+		SET count = shortcut_view_stat.count + EXCLUDED.count
+	`
+	if _, err := db.ExecContext(ctx, stmt,
+		increase.ShortcutID,
+		increase.DayTs,
+		increase.Referer,
+		increase.Device,
+		increase.Browser,
+		increase.Count,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListShortcutViewStats(ctx context.Context, find *store.FindShortcutViewStat) ([]*store.ShortcutViewStat, error) {
+	where, args := findShortcutViewStatWhere(find)
+	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			shortcut_id,
+			day_ts,
+			referer,
+			device,
+			browser,
+			count
+		FROM shortcut_view_stat
+		WHERE %s
+		ORDER BY day_ts ASC
+	`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewStat{}
+	for rows.Next() {
+		stat := &store.ShortcutViewStat{}
+		if err := rows.Scan(
+			&stat.ShortcutID,
+			&stat.DayTs,
+			&stat.Referer,
+			&stat.Device,
+			&stat.Browser,
+			&stat.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) CountShortcutViews(ctx context.Context, find *store.FindShortcutViewStat) (int32, error) {
+	where, args := findShortcutViewStatWhere(find)
+	var count int32
+	if err := d.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT COALESCE(SUM(count), 0)
+		FROM shortcut_view_stat
+		WHERE %s
+	`, strings.Join(where, " AND ")), args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func findShortcutViewStatWhere(find *store.FindShortcutViewStat) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, fmt.Sprintf("shortcut_id = %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.DayTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("day_ts >= %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.DayTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("day_ts < %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	return where, args
+}
@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+
+	"github.com/yourselfhosted/slash/server/version"
+)
+
+// Backup writes a snapshot of the database to a new database file at path with VACUUM INTO.
+func (d *DB) Backup(ctx context.Context, path string) error {
+	_, err := d.db.ExecContext(ctx, `VACUUM INTO ?`, path)
+	return err
+}
+
+const (
+	// backupStepPages is the number of pages copied in each step of an online backup,
+	// so that the database is never held in memory as a whole.
+	backupStepPages = 1024
+)
+
+// backupToFile writes a copy of the database to a new database file at path with the SQLite online backup API.
+func (d *DB) backupToFile(ctx context.Context, path string) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return conn.Raw(func(driverConn any) error {
+		backuper, ok := driverConn.(interface {
+			NewBackup(dstURI string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return errors.New("sqlite connection does not support backing up")
+		}
+		backup, err := backuper.NewBackup(path)
+		if err != nil {
+			return errors.Wrap(err, "failed to start backing up")
+		}
+		for {
+			more, err := backup.Step(backupStepPages)
+			if err != nil {
+				_ = backup.Finish()
+				return errors.Wrap(err, "failed to back up")
+			}
+			if !more {
+				break
+			}
+		}
+		return backup.Finish()
+	})
+}
+
+// pruneMigrationBackups removes the backups before migration in the data directory
+// except the most recent ones of the retention. All the backups are kept when the retention is 0.
+func pruneMigrationBackups(dataDir string, retention int) error {
+	if retention <= 0 {
+		return nil
+	}
+	backupFilePaths, err := filepath.Glob(filepath.Join(dataDir, "slash_*_backup.db"))
+	if err != nil {
+		return err
+	}
+	if len(backupFilePaths) <= retention {
+		return nil
+	}
+	modTimes := map[string]int64{}
+	for _, backupFilePath := range backupFilePaths {
+		fileInfo, err := os.Stat(backupFilePath)
+		if err != nil {
+			return err
+		}
+		modTimes[backupFilePath] = fileInfo.ModTime().UnixNano()
+	}
+	sort.Slice(backupFilePaths, func(i, j int) bool {
+		return modTimes[backupFilePaths[i]] > modTimes[backupFilePaths[j]]
+	})
+	for _, backupFilePath := range backupFilePaths[retention:] {
+		if err := os.Remove(backupFilePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore replaces the database with the database file at path with the SQLite online backup API,
+// which swaps the content of the database atomically while the connections stay open.
+// A snapshot of an older version is migrated after restoring.
+func (d *DB) Restore(ctx context.Context, path string) error {
+	if err := d.checkSnapshot(ctx, path); err != nil {
+		return err
+	}
+
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.Raw(func(driverConn any) error {
+		restorer, ok := driverConn.(interface {
+			NewRestore(srcURI string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return errors.New("sqlite connection does not support restoring")
+		}
+		restore, err := restorer.NewRestore(path)
+		if err != nil {
+			return errors.Wrap(err, "failed to start restoring")
+		}
+		// Copy all pages in a single step, so that the database is never partially restored.
+		if _, err := restore.Step(-1); err != nil {
+			_ = restore.Finish()
+			return errors.Wrap(err, "failed to restore")
+		}
+		return restore.Finish()
+	}); err != nil {
+		return err
+	}
+
+	return d.Migrate(ctx)
+}
+
+// checkSnapshot checks that the file at path is an intact database of a version not newer than the current one.
+func (d *DB) checkSnapshot(ctx context.Context, path string) error {
+	snapshotDB, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		return errors.Wrap(err, "failed to open snapshot")
+	}
+	defer snapshotDB.Close()
+
+	var result string
+	if err := snapshotDB.QueryRowContext(ctx, `PRAGMA quick_check`).Scan(&result); err != nil {
+		return errors.Wrap(err, "failed to check snapshot")
+	}
+	if result != "ok" {
+		return errors.Errorf("snapshot is corrupted: %s", result)
+	}
+
+	rows, err := snapshotDB.QueryContext(ctx, `SELECT version FROM migration_history`)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the migration history of snapshot")
+	}
+	defer rows.Close()
+	schemaVersion := version.GetSchemaVersion(version.GetCurrentVersion(d.profile.Mode))
+	for rows.Next() {
+		var snapshotVersion string
+		if err := rows.Scan(&snapshotVersion); err != nil {
+			return err
+		}
+		if version.IsVersionGreaterThan(snapshotVersion, schemaVersion) {
+			return errors.Errorf("snapshot version %s is newer than the current version %s", snapshotVersion, schemaVersion)
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertCollaborator(ctx context.Context, upsert *store.Collaborator) (*store.Collaborator, error) {
+	table, column, err := getCollaboratorTable(upsert.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	set, args := []string{column, "user_id", "role"}, []any{upsert.ResourceID, upsert.UserID, upsert.Role.String()}
+	if upsert.CreatedTs != 0 {
+		// Keeps the original timestamp, e.g. when importing an archive.
+		set, args = append(set, "created_ts"), append(args, upsert.CreatedTs)
+	}
+
+	stmt := `
+		INSERT INTO ` + table + ` (
+			` + strings.Join(set, ", ") + `
+		)
+		VALUES (` + strings.Repeat("?, ", len(set)-1) + `?)
+		ON CONFLICT(` + column + `, user_id) DO UPDATE SET role = EXCLUDED.role
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	collaborator := upsert
+	return collaborator, nil
+}
+
+func (d *DB) ListCollaborators(ctx context.Context, find *store.FindCollaborator) ([]*store.Collaborator, error) {
+	table, column, err := getCollaboratorTable(find.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ResourceID; v != nil {
+		where, args = append(where, column+" = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			`+column+`,
+			user_id,
+			role,
+			created_ts
+		FROM `+table+`
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Collaborator, 0)
+	for rows.Next() {
+		collaborator := &store.Collaborator{
+			ResourceType: find.ResourceType,
+		}
+		if err := rows.Scan(
+			&collaborator.ResourceID,
+			&collaborator.UserID,
+			&collaborator.Role,
+			&collaborator.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collaborator)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteCollaborator(ctx context.Context, delete *store.DeleteCollaborator) error {
+	table, column, err := getCollaboratorTable(delete.ResourceType)
+	if err != nil {
+		return err
+	}
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM `+table+` WHERE `+column+` = ? AND user_id = ?`, delete.ResourceID, delete.UserID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DB) TransferResourceOwnership(ctx context.Context, transfer *store.TransferResourceOwnership) error {
+	table, column, err := getCollaboratorTable(transfer.ResourceType)
+	if err != nil {
+		return err
+	}
+	// The tables of the resources are named after their types, e.g. shortcut for SHORTCUT.
+	resourceTable := strings.ToLower(string(transfer.ResourceType))
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE `+resourceTable+` SET creator_id = ? WHERE id = ?`, transfer.ToUserID, transfer.ResourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE `+column+` = ? AND user_id = ?`, transfer.ResourceID, transfer.ToUserID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// getCollaboratorTable returns the table of the collaborators of the resource type, and its column of the resource id.
+func getCollaboratorTable(resourceType store.CollaboratorResourceType) (string, string, error) {
+	switch resourceType {
+	case store.CollaboratorResourceTypeShortcut:
+		return "shortcut_collaborator", "shortcut_id", nil
+	case store.CollaboratorResourceTypeCollection:
+		return "collection_collaborator", "collection_id", nil
+	}
+	return "", "", errors.Errorf("invalid collaborator resource type %q", resourceType)
+}
+
+func vacuumCollaborator(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM shortcut_collaborator WHERE shortcut_id NOT IN (SELECT id FROM shortcut) OR user_id NOT IN (SELECT id FROM user)`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	stmt = `DELETE FROM collection_collaborator WHERE collection_id NOT IN (SELECT id FROM collection) OR user_id NOT IN (SELECT id FROM user)`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+
+	return nil
+}
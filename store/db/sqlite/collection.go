@@ -14,9 +14,14 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String()}
-	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "deleted_ts", "group_ids"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, formatIDList(create.ShortcutIds), create.Visibility.String(), create.DeletedTs, formatIDList(create.GroupIds)}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?"}
+	if create.Id != 0 {
+		// Recreates the collection with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.Id, create.CreatedTs, create.UpdatedTs)
+		placeholder = append(placeholder, "?", "?", "?")
+	}
 
 	stmt := `
 		INSERT INTO collection (
@@ -48,11 +53,17 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		set, args = append(set, "description = ?"), append(args, *update.Description)
 	}
 	if update.ShortcutIDs != nil {
-		set, args = append(set, "shortcut_ids = ?"), append(args, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(update.ShortcutIDs)), ","), "[]"))
+		set, args = append(set, "shortcut_ids = ?"), append(args, formatIDList(update.ShortcutIDs))
 	}
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = ?"), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		set, args = append(set, "group_ids = ?"), append(args, formatIDList(update.GroupIDs))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -64,10 +75,10 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, deleted_ts, group_ids
 	`
 	collection := &storepb.Collection{}
-	var shortcutIDs, visibility string
+	var shortcutIDs, visibility, groupIDs string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
 		&collection.CreatorId,
@@ -78,19 +89,23 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		&shortcutIDs,
 		&visibility,
+		&collection.DeletedTs,
+		&groupIDs,
 	); err != nil {
 		return nil, err
 	}
 
-	collection.ShortcutIds = []int32{}
-	if shortcutIDs != "" {
-		for _, idStr := range strings.Split(shortcutIDs, ",") {
-			shortcutID, err := util.ConvertStringToInt32(idStr)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert shortcut id")
-			}
-			collection.ShortcutIds = append(collection.ShortcutIds, shortcutID)
+	shortcutIDList, err := parseIDList(shortcutIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert shortcut id")
+	}
+	collection.ShortcutIds = shortcutIDList
+	if groupIDs != "" {
+		groupIDList, err := parseIDList(groupIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert group id")
 		}
+		collection.GroupIds = groupIDList
 	}
 	collection.Visibility = convertVisibilityStringToStorepb(visibility)
 	return collection, nil
@@ -115,6 +130,11 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if find.OnlyDeleted {
+		where = append(where, "deleted_ts > 0")
+	} else if !find.IncludeDeleted {
+		where = append(where, "deleted_ts = 0")
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -126,7 +146,9 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			deleted_ts,
+			group_ids
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -140,7 +162,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	list := make([]*storepb.Collection, 0)
 	for rows.Next() {
 		collection := &storepb.Collection{}
-		var shortcutIDs, visibility string
+		var shortcutIDs, visibility, groupIDs string
 		if err := rows.Scan(
 			&collection.Id,
 			&collection.CreatorId,
@@ -151,19 +173,23 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			&shortcutIDs,
 			&visibility,
+			&collection.DeletedTs,
+			&groupIDs,
 		); err != nil {
 			return nil, err
 		}
 
-		collection.ShortcutIds = []int32{}
-		if shortcutIDs != "" {
-			for _, idStr := range strings.Split(shortcutIDs, ",") {
-				shortcutID, err := util.ConvertStringToInt32(idStr)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to convert shortcut id")
-				}
-				collection.ShortcutIds = append(collection.ShortcutIds, shortcutID)
+		shortcutIDList, err := parseIDList(shortcutIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert shortcut id")
+		}
+		collection.ShortcutIds = shortcutIDList
+		if groupIDs != "" {
+			groupIDList, err := parseIDList(groupIDs)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert group id")
 			}
+			collection.GroupIds = groupIDList
 		}
 		collection.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
 		list = append(list, collection)
@@ -176,11 +202,40 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 }
 
 func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_collaborator WHERE collection_id = ?`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// formatIDList formats the ids as a comma separated list, e.g. "1,2,3".
+func formatIDList(ids []int32) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ","), "[]")
+}
+
+func parseIDList(s string) ([]int32, error) {
+	ids := []int32{}
+	if s == "" {
+		return ids, nil
+	}
+	for _, idStr := range strings.Split(s, ",") {
+		id, err := util.ConvertStringToInt32(idStr)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
 func vacuumCollection(ctx context.Context, tx *sql.Tx) error {
@@ -43,13 +43,56 @@ CREATE TABLE shortcut (
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  fallback_link TEXT NOT NULL DEFAULT '',
+  deleted_ts BIGINT NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  shortcut_id INTEGER NOT NULL,
+  alias TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
+-- shortcut_fts
+-- The index keeps its own copy of the content, as the aliases are in another table.
+CREATE VIRTUAL TABLE shortcut_fts USING fts5(
+  name,
+  alias,
+  title,
+  description,
+  tag,
+  link
+);
+
+CREATE TRIGGER shortcut_fts_after_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, alias, title, description, tag, link) VALUES (new.id, new.name, '', new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_after_delete AFTER DELETE ON shortcut BEGIN
+  DELETE FROM shortcut_fts WHERE rowid = old.id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_update AFTER UPDATE ON shortcut BEGIN
+  UPDATE shortcut_fts SET name = new.name, title = new.title, description = new.description, tag = new.tag, link = new.link WHERE rowid = new.id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_alias_insert AFTER INSERT ON shortcut_alias BEGIN
+  UPDATE shortcut_fts SET alias = (SELECT COALESCE(group_concat(alias, ' '), '') FROM shortcut_alias WHERE shortcut_id = new.shortcut_id) WHERE rowid = new.shortcut_id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_alias_delete AFTER DELETE ON shortcut_alias BEGIN
+  UPDATE shortcut_fts SET alias = (SELECT COALESCE(group_concat(alias, ' '), '') FROM shortcut_alias WHERE shortcut_id = old.shortcut_id) WHERE rowid = old.shortcut_id;
+END;
+
 -- activity
 CREATE TABLE activity (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -60,6 +103,17 @@ CREATE TABLE activity (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+-- shortcut_view_stat
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INTEGER NOT NULL,
+  day_ts BIGINT NOT NULL,
+  referer TEXT NOT NULL DEFAULT '',
+  device TEXT NOT NULL DEFAULT '',
+  browser TEXT NOT NULL DEFAULT '',
+  count INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(shortcut_id, day_ts, referer, device, browser)
+);
+
 -- collection
 CREATE TABLE collection (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -70,7 +124,70 @@ CREATE TABLE collection (
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER[] NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids INTEGER[] NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- shortcut_group
+CREATE TABLE shortcut_group (
+  shortcut_id INTEGER NOT NULL,
+  group_id INTEGER NOT NULL,
+  UNIQUE(shortcut_id, group_id)
+);
+
+CREATE INDEX idx_shortcut_group_group_id ON shortcut_group(group_id);
+
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_collaborator_user_id ON shortcut_collaborator(user_id);
+
+-- collection_collaborator
+CREATE TABLE collection_collaborator (
+  collection_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(collection_id, user_id)
+);
+
+CREATE INDEX idx_collection_collaborator_user_id ON collection_collaborator(user_id);
+
+-- user_identity
+CREATE TABLE user_identity (
+  user_id INTEGER NOT NULL,
+  provider_id TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(provider_id, subject)
+);
+
+CREATE INDEX idx_user_identity_user_id ON user_identity(user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE shortcut ADD COLUMN start_ts BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE shortcut ADD COLUMN expire_ts BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE shortcut ADD COLUMN fallback_link TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,22 @@
+-- shortcut_view_stat
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INTEGER NOT NULL,
+  day_ts BIGINT NOT NULL,
+  referer TEXT NOT NULL DEFAULT '',
+  device TEXT NOT NULL DEFAULT '',
+  browser TEXT NOT NULL DEFAULT '',
+  count INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(shortcut_id, day_ts, referer, device, browser)
+);
+
+-- Aggregate the views recorded before the stats. Their devices and browsers are left unknown,
+-- as the user agents are only parsed by the server.
+INSERT INTO shortcut_view_stat (shortcut_id, day_ts, referer, count)
+SELECT
+  json_extract(payload, '$.shortcutId'),
+  created_ts - created_ts % 86400,
+  COALESCE(json_extract(payload, '$.referer'), ''),
+  COUNT(*)
+FROM activity
+WHERE type = 'shortcut.view' AND json_extract(payload, '$.shortcutId') IS NOT NULL
+GROUP BY 1, 2, 3;
@@ -0,0 +1,41 @@
+-- shortcut_fts
+-- The index keeps its own copy of the content, as the aliases are in another table.
+CREATE VIRTUAL TABLE shortcut_fts USING fts5(
+  name,
+  alias,
+  title,
+  description,
+  tag,
+  link
+);
+
+CREATE TRIGGER shortcut_fts_after_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, alias, title, description, tag, link) VALUES (new.id, new.name, '', new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_after_delete AFTER DELETE ON shortcut BEGIN
+  DELETE FROM shortcut_fts WHERE rowid = old.id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_update AFTER UPDATE ON shortcut BEGIN
+  UPDATE shortcut_fts SET name = new.name, title = new.title, description = new.description, tag = new.tag, link = new.link WHERE rowid = new.id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_alias_insert AFTER INSERT ON shortcut_alias BEGIN
+  UPDATE shortcut_fts SET alias = (SELECT COALESCE(group_concat(alias, ' '), '') FROM shortcut_alias WHERE shortcut_id = new.shortcut_id) WHERE rowid = new.shortcut_id;
+END;
+
+CREATE TRIGGER shortcut_fts_after_alias_delete AFTER DELETE ON shortcut_alias BEGIN
+  UPDATE shortcut_fts SET alias = (SELECT COALESCE(group_concat(alias, ' '), '') FROM shortcut_alias WHERE shortcut_id = old.shortcut_id) WHERE rowid = old.shortcut_id;
+END;
+
+INSERT INTO shortcut_fts(rowid, name, alias, title, description, tag, link)
+SELECT
+  id,
+  name,
+  (SELECT COALESCE(group_concat(alias, ' '), '') FROM shortcut_alias WHERE shortcut_id = shortcut.id),
+  title,
+  description,
+  tag,
+  link
+FROM shortcut;
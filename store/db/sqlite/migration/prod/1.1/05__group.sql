@@ -0,0 +1,92 @@
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- shortcut_group
+CREATE TABLE shortcut_group (
+  shortcut_id INTEGER NOT NULL,
+  group_id INTEGER NOT NULL,
+  UNIQUE(shortcut_id, group_id)
+);
+
+CREATE INDEX idx_shortcut_group_group_id ON shortcut_group(group_id);
+
+-- SQLite cannot alter the visibility check constraints, so the shortcut and collection tables are recreated.
+CREATE TABLE shortcut_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  name TEXT NOT NULL UNIQUE,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
+  tag TEXT NOT NULL DEFAULT '',
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  fallback_link TEXT NOT NULL DEFAULT '',
+  deleted_ts BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO shortcut_new (id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, start_ts, expire_ts, fallback_link, deleted_ts)
+SELECT id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, start_ts, expire_ts, fallback_link, deleted_ts FROM shortcut;
+
+DROP TABLE shortcut;
+
+ALTER TABLE shortcut_new RENAME TO shortcut;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+
+CREATE TRIGGER shortcut_fts_after_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TRIGGER shortcut_fts_after_delete AFTER DELETE ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+END;
+
+CREATE TRIGGER shortcut_fts_after_update AFTER UPDATE ON shortcut BEGIN
+  INSERT INTO shortcut_fts(shortcut_fts, rowid, name, title, description, tag, link) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag, old.link);
+  INSERT INTO shortcut_fts(rowid, name, title, description, tag, link) VALUES (new.id, new.name, new.title, new.description, new.tag, new.link);
+END;
+
+CREATE TABLE collection_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  shortcut_ids INTEGER[] NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids INTEGER[] NOT NULL DEFAULT ''
+);
+
+INSERT INTO collection_new (id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, deleted_ts)
+SELECT id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, deleted_ts FROM collection;
+
+DROP TABLE collection;
+
+ALTER TABLE collection_new RENAME TO collection;
+
+CREATE INDEX idx_collection_name ON collection(name);
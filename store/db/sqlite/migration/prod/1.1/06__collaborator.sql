@@ -0,0 +1,21 @@
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(shortcut_id, user_id)
+);
+
+CREATE INDEX idx_shortcut_collaborator_user_id ON shortcut_collaborator(user_id);
+
+-- collection_collaborator
+CREATE TABLE collection_collaborator (
+  collection_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(collection_id, user_id)
+);
+
+CREATE INDEX idx_collection_collaborator_user_id ON collection_collaborator(user_id);
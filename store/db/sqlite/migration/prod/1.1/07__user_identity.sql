@@ -0,0 +1,10 @@
+-- user_identity
+CREATE TABLE user_identity (
+  user_id INTEGER NOT NULL,
+  provider_id TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(provider_id, subject)
+);
+
+CREATE INDEX idx_user_identity_user_id ON user_identity(user_id);
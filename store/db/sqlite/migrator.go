@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"path"
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -22,99 +25,38 @@ var migrationFS embed.FS
 //go:embed seed
 var seedFS embed.FS
 
-// Migrate applies the latest schema to the database.
+// Migrate applies the pending migrations to the database, each one in its own transaction.
+// An existing database is backed up with the online backup API before it is migrated,
+// and the backup is kept according to the backup retention.
 func (d *DB) Migrate(ctx context.Context) error {
-	currentVersion := version.GetCurrentVersion(d.profile.Mode)
-	if d.profile.Mode == "prod" {
-		_, err := os.Stat(d.profile.DSN)
-		if err != nil {
-			// If db file not exists, we should create a new one with latest schema.
-			if errors.Is(err, os.ErrNotExist) {
-				if err := d.applyLatestSchema(ctx); err != nil {
-					return errors.Wrap(err, "failed to apply latest schema")
-				}
-				// Upsert the newest version to migration_history.
-				if _, err := d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{
-					Version: currentVersion,
-				}); err != nil {
-					return errors.Wrap(err, "failed to upsert migration history")
-				}
-			} else {
-				return errors.Wrap(err, "failed to get db file stat")
-			}
-		} else {
-			// If db file exists, we should check if we need to migrate the database.
-			migrationHistoryList, err := d.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
-			if err != nil {
-				return errors.Wrap(err, "failed to find migration history")
-			}
-			// If no migration history, we should apply the latest version migration and upsert the migration history.
-			if len(migrationHistoryList) == 0 {
-				minorVersion := version.GetMinorVersion(currentVersion)
-				if err := d.applyMigrationForMinorVersion(ctx, minorVersion); err != nil {
-					return errors.Wrapf(err, "failed to apply version %s migration", minorVersion)
-				}
-				_, err := d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{
-					Version: currentVersion,
-				})
-				if err != nil {
-					return errors.Wrap(err, "failed to upsert migration history")
-				}
-				return nil
-			}
+	migrations, err := d.ListPendingMigrations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending migrations")
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
 
-			migrationHistoryVersionList := []string{}
-			for _, migrationHistory := range migrationHistoryList {
-				migrationHistoryVersionList = append(migrationHistoryVersionList, migrationHistory.Version)
-			}
-			sort.Sort(version.SortVersion(migrationHistoryVersionList))
-			latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
-
-			if version.IsVersionGreaterThan(version.GetSchemaVersion(currentVersion), latestMigrationHistoryVersion) {
-				minorVersionList := getMinorVersionList()
-				// backup the raw database file before migration
-				rawBytes, err := os.ReadFile(d.profile.DSN)
-				if err != nil {
-					return errors.Wrap(err, "failed to read raw database file")
-				}
-				backupDBFilePath := fmt.Sprintf("%s/slash_%s_%d_backup.db", d.profile.Data, d.profile.Version, time.Now().Unix())
-				if err := os.WriteFile(backupDBFilePath, rawBytes, 0644); err != nil {
-					return errors.Wrap(err, "failed to write raw database file")
-				}
-				println("succeed to copy a backup database file")
-				println("start migrate")
-				for _, minorVersion := range minorVersionList {
-					normalizedVersion := minorVersion + ".0"
-					if version.IsVersionGreaterThan(normalizedVersion, latestMigrationHistoryVersion) && version.IsVersionGreaterOrEqualThan(currentVersion, normalizedVersion) {
-						println("applying migration for", normalizedVersion)
-						if err := d.applyMigrationForMinorVersion(ctx, minorVersion); err != nil {
-							return errors.Wrap(err, "failed to apply minor version migration")
-						}
-					}
-				}
-				println("end migrate")
-
-				// remove the created backup db file after migrate succeed
-				if err := os.Remove(backupDBFilePath); err != nil {
-					println(fmt.Sprintf("Failed to remove temp database file, err %v", err))
-				}
-			}
+	// Only existing databases have migration history, new ones get the latest schema.
+	if d.profile.Mode == "prod" && !isLatestSchemaMigration(migrations) {
+		backupDBFilePath := filepath.Join(d.profile.Data, fmt.Sprintf("slash_%s_%d_backup.db", d.profile.Version, time.Now().Unix()))
+		if err := d.backupToFile(ctx, backupDBFilePath); err != nil {
+			return errors.Wrap(err, "failed to back up the database before migration")
 		}
-	} else {
-		// In non-prod mode, we should always migrate the database.
-		if _, err := os.Stat(d.profile.DSN); errors.Is(err, os.ErrNotExist) {
-			if err := d.applyLatestSchema(ctx); err != nil {
-				return errors.Wrap(err, "failed to apply latest schema")
-			}
-			// In demo mode, we should seed the database.
-			if d.profile.Mode == "demo" {
-				if err := d.Seed(ctx); err != nil {
-					return errors.Wrap(err, "failed to seed")
-				}
-			}
+		println("succeed to back up the database to", backupDBFilePath)
+		if err := pruneMigrationBackups(d.profile.Data, d.profile.MigrationBackupRetention); err != nil {
+			println(fmt.Sprintf("Failed to remove old backup database files, err %v", err))
 		}
 	}
 
+	println("start migrate")
+	for _, migration := range migrations {
+		println("applying migration for", migration.Version)
+		if err := d.applyMigration(ctx, migration); err != nil {
+			return errors.Wrapf(err, "failed to apply migration for %s", migration.Version)
+		}
+	}
+	println("end migrate")
 	return nil
 }
 
@@ -122,93 +64,120 @@ const (
 	latestSchemaFileName = "LATEST.sql"
 )
 
-func (d *DB) applyLatestSchema(ctx context.Context) error {
+// ListPendingMigrations returns the migrations which are applied by the next Migrate.
+// A new database file gets the latest schema, and an existing one the migrations of the minor versions
+// between the latest migration history version and the current version.
+func (d *DB) ListPendingMigrations(ctx context.Context) ([]*store.PendingMigration, error) {
+	currentVersion := version.GetCurrentVersion(d.profile.Mode)
 	schemaMode := "dev"
 	if d.profile.Mode == "prod" {
 		schemaMode = "prod"
 	}
-	latestSchemaPath := fmt.Sprintf("migration/%s/%s", schemaMode, latestSchemaFileName)
-	buf, err := migrationFS.ReadFile(latestSchemaPath)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read latest schema %q", latestSchemaPath)
+	if _, err := os.Stat(d.profile.DSN); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, errors.Wrap(err, "failed to get db file stat")
+		}
+		files := []string{fmt.Sprintf("migration/%s/%s", schemaMode, latestSchemaFileName)}
+		if d.profile.Mode == "demo" {
+			seedFilenames, err := fs.Glob(seedFS, "seed/*.sql")
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read seed files")
+			}
+			sort.Strings(seedFilenames)
+			files = append(files, seedFilenames...)
+		}
+		return []*store.PendingMigration{{Version: currentVersion, Files: files}}, nil
 	}
-	stmt := string(buf)
-	if err := d.execute(ctx, stmt); err != nil {
-		return errors.Wrapf(err, "migrate error: %s", stmt)
+	// In non-prod mode, existing databases are never migrated.
+	if d.profile.Mode != "prod" {
+		return []*store.PendingMigration{}, nil
 	}
-	return nil
-}
 
-func (d *DB) applyMigrationForMinorVersion(ctx context.Context, minorVersion string) error {
-	filenames, err := fs.Glob(migrationFS, fmt.Sprintf("%s/%s/*.sql", "migration/prod", minorVersion))
+	migrationHistoryList, err := d.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
 	if err != nil {
-		return errors.Wrap(err, "failed to read ddl files")
+		return nil, errors.Wrap(err, "failed to find migration history")
 	}
-
-	sort.Strings(filenames)
-	migrationStmt := ""
-
-	// Loop over all migration files and execute them in order.
-	for _, filename := range filenames {
-		buf, err := migrationFS.ReadFile(filename)
+	if len(migrationHistoryList) == 0 {
+		filenames, err := getMigrationFileNames(version.GetMinorVersion(currentVersion))
 		if err != nil {
-			return errors.Wrapf(err, "failed to read minor version migration file, filename=%s", filename)
-		}
-		stmt := string(buf)
-		migrationStmt += stmt
-		if err := d.execute(ctx, stmt); err != nil {
-			return errors.Wrapf(err, "migrate error: %s", stmt)
+			return nil, err
 		}
+		return []*store.PendingMigration{{Version: currentVersion, Files: filenames}}, nil
 	}
 
-	// Upsert the newest version to migration_history.
-	version := minorVersion + ".0"
-	if _, err = d.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{
-		Version: version,
-	}); err != nil {
-		return errors.Wrapf(err, "failed to upsert migration history with version: %s", version)
+	migrationHistoryVersionList := []string{}
+	for _, migrationHistory := range migrationHistoryList {
+		migrationHistoryVersionList = append(migrationHistoryVersionList, migrationHistory.Version)
+	}
+	sort.Sort(version.SortVersion(migrationHistoryVersionList))
+	latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
+	migrations := []*store.PendingMigration{}
+	if !version.IsVersionGreaterThan(version.GetSchemaVersion(currentVersion), latestMigrationHistoryVersion) {
+		return migrations, nil
+	}
+	for _, minorVersion := range getMinorVersionList() {
+		normalizedVersion := minorVersion + ".0"
+		if version.IsVersionGreaterThan(normalizedVersion, latestMigrationHistoryVersion) && version.IsVersionGreaterOrEqualThan(currentVersion, normalizedVersion) {
+			filenames, err := getMigrationFileNames(minorVersion)
+			if err != nil {
+				return nil, err
+			}
+			migrations = append(migrations, &store.PendingMigration{Version: normalizedVersion, Files: filenames})
+		}
 	}
+	return migrations, nil
+}
 
-	return nil
+// isLatestSchemaMigration reports whether the migrations create a new database from the latest schema.
+func isLatestSchemaMigration(migrations []*store.PendingMigration) bool {
+	return len(migrations) == 1 && len(migrations[0].Files) > 0 && path.Base(migrations[0].Files[0]) == latestSchemaFileName
 }
 
-func (d *DB) Seed(ctx context.Context) error {
-	filenames, err := fs.Glob(seedFS, fmt.Sprintf("%s/*.sql", "seed"))
+// getMigrationFileNames returns the sorted migration files of the minor version.
+func getMigrationFileNames(minorVersion string) ([]string, error) {
+	filenames, err := fs.Glob(migrationFS, fmt.Sprintf("%s/%s/*.sql", "migration/prod", minorVersion))
 	if err != nil {
-		return errors.Wrap(err, "failed to read seed files")
+		return nil, errors.Wrap(err, "failed to read ddl files")
 	}
-
 	sort.Strings(filenames)
-
-	// Loop over all seed files and execute them in order.
-	for _, filename := range filenames {
-		buf, err := seedFS.ReadFile(filename)
-		if err != nil {
-			return errors.Wrapf(err, "failed to read seed file, filename=%s", filename)
-		}
-		stmt := string(buf)
-		if err := d.execute(ctx, stmt); err != nil {
-			return errors.Wrapf(err, "seed error: %s", stmt)
-		}
-	}
-	return nil
+	return filenames, nil
 }
 
-// execute runs a single SQL statement within a transaction.
-func (d *DB) execute(ctx context.Context, stmt string) error {
-	tx, err := d.db.Begin()
+// applyMigration runs the files of the migration and records its version in a single transaction,
+// so a failed migration is rolled back and leaves the database as it was.
+func (d *DB) applyMigration(ctx context.Context, migration *store.PendingMigration) error {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx, stmt); err != nil {
-		return errors.Wrap(err, "failed to execute statement")
+	// Loop over all migration files and execute them in order.
+	for _, filename := range migration.Files {
+		buf, err := readMigrationFile(filename)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read migration file, filename=%s", filename)
+		}
+		if _, err := tx.ExecContext(ctx, string(buf)); err != nil {
+			return errors.Wrapf(err, "migrate error: %s", filename)
+		}
 	}
 
+	// Upsert the newest version to migration_history.
+	if _, err := upsertMigrationHistory(ctx, tx, &store.UpsertMigrationHistory{Version: migration.Version}); err != nil {
+		return errors.Wrapf(err, "failed to upsert migration history with version: %s", migration.Version)
+	}
 	return tx.Commit()
 }
 
+// readMigrationFile reads a migration file, or a seed file for the migrations of the demo mode.
+func readMigrationFile(filename string) ([]byte, error) {
+	if strings.HasPrefix(filename, "seed/") {
+		return seedFS.ReadFile(filename)
+	}
+	return migrationFS.ReadFile(filename)
+}
+
 // minorDirRegexp is a regular expression for minor version directory.
 var minorDirRegexp = regexp.MustCompile(`^migration/prod/[0-9]+\.[0-9]+$`)
 
@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) SearchShortcuts(ctx context.Context, search *store.SearchShortcut) ([]*store.ShortcutSearchResult, error) {
+	// Every term is a quoted prefix query, and FTS5 requires all of them to match.
+	terms := []string{}
+	for _, term := range search.Terms {
+		terms = append(terms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"*`)
+	}
+	where, args := []string{"shortcut_fts MATCH ?", "shortcut.deleted_ts = 0"}, []any{strings.Join(terms, " ")}
+	if v := search.ViewerID; v != nil {
+		where, args = append(where, shortcutViewerCondition), append(args, *v, *v, *v)
+	}
+	limitClause := ""
+	if search.Limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", search.Limit)
+	}
+
+	// The bm25 weights of the name, alias, title, description, tag and link columns.
+	rank := "bm25(shortcut_fts, 10.0, 10.0, 5.0, 2.0, 5.0, 1.0)"
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			shortcut.id,
+			-`+rank+`,
+			snippet(shortcut_fts, -1, ?, ?, '...', 16)
+		FROM shortcut_fts
+		JOIN shortcut ON shortcut.id = shortcut_fts.rowid
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY `+rank+` ASC, shortcut.id DESC`+limitClause,
+		append([]any{store.SearchMatchStart, store.SearchMatchEnd}, args...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutSearchResult{}
+	for rows.Next() {
+		result := &store.ShortcutSearchResult{}
+		if err := rows.Scan(&result.ShortcutID, &result.Rank, &result.Snippet); err != nil {
+			return nil, err
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
 
@@ -9,22 +10,24 @@ import (
 )
 
 func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
+	set := []string{"email", "nickname", "password_hash", "role"}
+	args := []any{create.Email, create.Nickname, create.PasswordHash, create.Role}
+	if create.ID != 0 {
+		// Recreates the user with its id and timestamps, e.g. when importing an archive.
+		set, args = append(set, "id", "created_ts", "updated_ts"), append(args, create.ID, create.CreatedTs, create.UpdatedTs)
+	}
+	if create.RowStatus != "" {
+		set, args = append(set, "row_status"), append(args, create.RowStatus)
+	}
+
 	stmt := `
 		INSERT INTO user (
-			email,
-			nickname,
-			password_hash,
-			role
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (` + strings.Repeat("?, ", len(set)-1) + `?)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.Email,
-		create.Nickname,
-		create.PasswordHash,
-		create.Role,
-	).Scan(
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -59,6 +62,17 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		return nil, errors.New("no fields to update")
 	}
 
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if update.TransferTo != nil {
+		if err := transferUserResources(ctx, tx, update.ID, *update.TransferTo); err != nil {
+			return nil, err
+		}
+	}
 	stmt := `
 		UPDATE user
 		SET ` + strings.Join(set, ", ") + `
@@ -67,7 +81,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
 		&user.ID,
 		&user.CreatedTs,
 		&user.UpdatedTs,
@@ -79,6 +93,9 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	); err != nil {
 		return nil, err
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return user, nil
 }
@@ -154,6 +171,11 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	}
 	defer tx.Rollback()
 
+	if delete.TransferTo != nil {
+		if err := transferUserResources(ctx, tx, delete.ID, *delete.TransferTo); err != nil {
+			return err
+		}
+	}
 	if _, err := tx.ExecContext(ctx, `
 		DELETE FROM user WHERE id = ?
 	`, delete.ID); err != nil {
@@ -166,9 +188,57 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if err := vacuumShortcut(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumShortcutAlias(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumCollection(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumGroupMember(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutGroup(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumCollaborator(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumUserIdentity(ctx, tx); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
+
+func (d *DB) TransferUserResources(ctx context.Context, transfer *store.TransferUserResources) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := transferUserResources(ctx, tx, transfer.FromUserID, transfer.ToUserID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// transferUserResources reassigns the shortcuts and collections of a user to another user,
+// who does not need to stay a collaborator of them.
+func transferUserResources(ctx context.Context, tx *sql.Tx, fromUserID, toUserID int32) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET creator_id = ? WHERE creator_id = ?`, toUserID, fromUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE collection SET creator_id = ? WHERE creator_id = ?`, toUserID, fromUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut_collaborator WHERE user_id = ? AND shortcut_id IN (SELECT id FROM shortcut WHERE creator_id = ?)`, toUserID, toUserID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_collaborator WHERE user_id = ? AND collection_id IN (SELECT id FROM collection WHERE creator_id = ?)`, toUserID, toUserID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -43,8 +43,22 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Workspa
 		valueString = upsert.GetInstanceUrl()
 	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY {
 		valueString = upsert.GetDefaultVisibility().String()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS {
+		valueString = strconv.Itoa(int(upsert.GetTrashRetentionDays()))
 	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FAVICON_PROVIDER {
 		valueString = upsert.GetFaviconProvider()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS {
+		valueBytes, err := protojson.Marshal(upsert.GetIdentityProviders())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP {
+		valueBytes, err := protojson.Marshal(upsert.GetLdap())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid workspace setting key")
 	}
@@ -112,8 +126,26 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 			workspaceSetting.Value = &storepb.WorkspaceSetting_InstanceUrl{InstanceUrl: valueString}
 		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_VISIBILITY {
 			workspaceSetting.Value = &storepb.WorkspaceSetting_DefaultVisibility{DefaultVisibility: storepb.Visibility(storepb.Visibility_value[valueString])}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TRASH_RETENTION_DAYS {
+			trashRetentionDays, err := strconv.Atoi(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_TrashRetentionDays{TrashRetentionDays: int32(trashRetentionDays)}
 		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_FAVICON_PROVIDER {
 			workspaceSetting.Value = &storepb.WorkspaceSetting_FaviconProvider{FaviconProvider: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDERS {
+			identityProvidersSetting := &storepb.IdentityProvidersWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), identityProvidersSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_IdentityProviders{IdentityProviders: identityProvidersSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LDAP {
+			ldapSetting := &storepb.LDAPWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), ldapSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Ldap{Ldap: ldapSetting}
 		} else {
 			continue
 		}
@@ -14,6 +14,9 @@ type Driver interface {
 	Close() error
 
 	Migrate(ctx context.Context) error
+	ListPendingMigrations(ctx context.Context) ([]*PendingMigration, error)
+	Backup(ctx context.Context, path string) error
+	Restore(ctx context.Context, path string) error
 
 	// MigrationHistory model related methods.
 	UpsertMigrationHistory(ctx context.Context, upsert *UpsertMigrationHistory) (*MigrationHistory, error)
@@ -34,12 +37,37 @@ type Driver interface {
 	UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error)
 	ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error)
 	DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error
+	SearchShortcuts(ctx context.Context, search *SearchShortcut) ([]*ShortcutSearchResult, error)
+
+	// Group model related methods.
+	CreateGroup(ctx context.Context, create *Group) (*Group, error)
+	UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error)
+	ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error)
+	DeleteGroup(ctx context.Context, delete *DeleteGroup) error
+	UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error)
+	ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error)
+	DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error
+
+	// Collaborator model related methods.
+	UpsertCollaborator(ctx context.Context, upsert *Collaborator) (*Collaborator, error)
+	ListCollaborators(ctx context.Context, find *FindCollaborator) ([]*Collaborator, error)
+	DeleteCollaborator(ctx context.Context, delete *DeleteCollaborator) error
+	TransferResourceOwnership(ctx context.Context, transfer *TransferResourceOwnership) error
+
+	// ShortcutViewStat model related methods.
+	ListShortcutViewStats(ctx context.Context, find *FindShortcutViewStat) ([]*ShortcutViewStat, error)
+	CountShortcutViews(ctx context.Context, find *FindShortcutViewStat) (int32, error)
 
 	// User model related methods.
 	CreateUser(ctx context.Context, create *User) (*User, error)
 	UpdateUser(ctx context.Context, update *UpdateUser) (*User, error)
 	ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 	DeleteUser(ctx context.Context, delete *DeleteUser) error
+	TransferUserResources(ctx context.Context, transfer *TransferUserResources) error
+
+	// UserIdentity model related methods.
+	CreateUserIdentity(ctx context.Context, create *UserIdentity) (*UserIdentity, error)
+	ListUserIdentities(ctx context.Context, find *FindUserIdentity) ([]*UserIdentity, error)
 
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error)
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"slices"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// Group is a set of users which shortcuts and collections can be shared with.
+type Group struct {
+	ID int32
+
+	// Standard fields
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Domain specific fields
+	Name        string
+	Description string
+}
+
+type UpdateGroup struct {
+	ID int32
+
+	Name        *string
+	Description *string
+}
+
+type FindGroup struct {
+	ID     *int32
+	IDList []int32
+	Name   *string
+	// MemberID matches the groups which the user is a member of.
+	MemberID *int32
+}
+
+type DeleteGroup struct {
+	ID int32
+}
+
+type GroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+type DeleteGroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
+func (s *Store) CreateGroup(ctx context.Context, create *Group) (*Group, error) {
+	return s.driver.CreateGroup(ctx, create)
+}
+
+func (s *Store) UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error) {
+	return s.driver.UpdateGroup(ctx, update)
+}
+
+func (s *Store) ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error) {
+	return s.driver.ListGroups(ctx, find)
+}
+
+func (s *Store) GetGroup(ctx context.Context, find *FindGroup) (*Group, error) {
+	groups, err := s.ListGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	group := groups[0]
+	return group, nil
+}
+
+// DeleteGroup deletes the group with its members, and stops sharing the shortcuts and collections with it.
+func (s *Store) DeleteGroup(ctx context.Context, delete *DeleteGroup) error {
+	if err := s.driver.DeleteGroup(ctx, delete); err != nil {
+		return err
+	}
+
+	// The shortcuts in the cache still have the group of the deleted group.
+	s.shortcutCache.Range(func(key, value any) bool {
+		if shortcut, ok := value.(*storepb.Shortcut); ok && slices.Contains(shortcut.GroupIds, delete.ID) {
+			s.shortcutCache.Delete(key)
+		}
+		return true
+	})
+	collections, err := s.driver.ListCollections(ctx, &FindCollection{IncludeDeleted: true})
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if !slices.Contains(collection.GroupIds, delete.ID) {
+			continue
+		}
+		groupIDs := []int32{}
+		for _, groupID := range collection.GroupIds {
+			if groupID != delete.ID {
+				groupIDs = append(groupIDs, groupID)
+			}
+		}
+		if _, err := s.driver.UpdateCollection(ctx, &UpdateCollection{
+			ID:       collection.Id,
+			GroupIDs: groupIDs,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error) {
+	return s.driver.UpsertGroupMember(ctx, upsert)
+}
+
+func (s *Store) ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error) {
+	return s.driver.ListGroupMembers(ctx, find)
+}
+
+func (s *Store) DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error {
+	return s.driver.DeleteGroupMember(ctx, delete)
+}
+
+// IsGroupMember returns true if the user is a member of any of the groups.
+func (s *Store) IsGroupMember(ctx context.Context, userID int32, groupIDs []int32) (bool, error) {
+	if len(groupIDs) == 0 {
+		return false, nil
+	}
+	members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+		UserID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if slices.Contains(groupIDs, member.GroupID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
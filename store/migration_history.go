@@ -11,3 +11,11 @@ type UpsertMigrationHistory struct {
 
 type FindMigrationHistory struct {
 }
+
+// PendingMigration is a migration which is applied by the next migrate of the database.
+type PendingMigration struct {
+	// Version is the schema version after the migration.
+	Version string
+	// Files are the SQL files of the migration, in the order they are applied.
+	Files []string
+}
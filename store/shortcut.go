@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"slices"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
@@ -17,17 +18,72 @@ type UpdateShortcut struct {
 	Visibility        *Visibility
 	Tag               *string
 	OpenGraphMetadata *storepb.OpenGraphMetadata
+	StartTs           *int64
+	ExpireTs          *int64
+	FallbackLink      *string
+	// Aliases replaces the aliases of the shortcut when not nil.
+	Aliases *[]string
+	// GroupIDs replaces the groups the shortcut is shared with when not nil.
+	GroupIDs *[]int32
+	// DeletedTs moves the shortcut to the trash when not zero, and out of it when zero.
+	DeletedTs *int64
+	// Revision records the update as a revision of the shortcut in the same transaction when not nil.
+	Revision *UpdateShortcutRevision
 }
 
+// FindShortcut is the filter of shortcuts, where Name matches either the name or one of the aliases of a shortcut.
 type FindShortcut struct {
 	ID             *int32
+	IDList         []int32
 	CreatorID      *int32
 	RowStatus      *RowStatus
 	Name           *string
 	VisibilityList []Visibility
 	Tag            *string
+	// ExpiredBefore matches the shortcuts with an expire time not after the given timestamp.
+	ExpiredBefore *int64
+	// ViewerID matches the shortcuts visible to the user, i.e. the workspace and public ones,
+	// the ones shared with the groups of the user or with the user as a collaborator,
+	// and the private ones created by the user.
+	ViewerID *int32
+	// NamePrefix matches the shortcuts with a name starting with the prefix, case-insensitively.
+	NamePrefix *string
+	// SimilarName matches the shortcuts with a name or an alias which can be similar to the name.
+	SimilarName *SimilarName
+	// CreatedTsAfter and UpdatedTsAfter are inclusive, while CreatedTsBefore and UpdatedTsBefore are exclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	UpdatedTsAfter  *int64
+	UpdatedTsBefore *int64
+	// The shortcuts in the trash are excluded unless IncludeDeleted is set,
+	// and OnlyDeleted matches the shortcuts in the trash only.
+	IncludeDeleted bool
+	OnlyDeleted    bool
+
+	// If OrderBy is empty, shortcuts are sorted by created_ts in descending order.
+	OrderBy   ShortcutOrderBy
+	OrderDesc bool
+	Limit     *int
+	Offset    *int
+}
+
+// SimilarName matches the names starting with the name case-insensitively, or with a length within
+// the maximum edit distance of its length, which are the candidates of the names similar to it.
+type SimilarName struct {
+	Name        string
+	MaxDistance int
 }
 
+// ShortcutOrderBy is the field to sort shortcuts by.
+type ShortcutOrderBy string
+
+const (
+	ShortcutOrderByCreatedTs ShortcutOrderBy = "created_ts"
+	ShortcutOrderByUpdatedTs ShortcutOrderBy = "updated_ts"
+	ShortcutOrderByName      ShortcutOrderBy = "name"
+	ShortcutOrderByViewCount ShortcutOrderBy = "view_count"
+)
+
 type DeleteShortcut struct {
 	ID int32
 }
@@ -64,7 +120,12 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.Shortcut, error) {
 	if find.ID != nil {
 		if cache, ok := s.shortcutCache.Load(*find.ID); ok {
-			return cache.(*storepb.Shortcut), nil
+			if shortcut, ok := cache.(*storepb.Shortcut); ok {
+				if !isShortcutMatchingDeleted(shortcut, find) {
+					return nil, nil
+				}
+				return shortcut, nil
+			}
 		}
 	}
 
@@ -82,11 +143,41 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	return shortcut, nil
 }
 
+// DeleteShortcut permanently deletes the shortcut and removes it from the collections.
 func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
 	if err := s.driver.DeleteShortcut(ctx, delete); err != nil {
 		return err
 	}
 
 	s.shortcutCache.Delete(delete.ID)
+	collections, err := s.driver.ListCollections(ctx, &FindCollection{IncludeDeleted: true})
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if !slices.Contains(collection.ShortcutIds, delete.ID) {
+			continue
+		}
+		shortcutIDs := []int32{}
+		for _, shortcutID := range collection.ShortcutIds {
+			if shortcutID != delete.ID {
+				shortcutIDs = append(shortcutIDs, shortcutID)
+			}
+		}
+		if _, err := s.driver.UpdateCollection(ctx, &UpdateCollection{
+			ID:          collection.Id,
+			ShortcutIDs: shortcutIDs,
+		}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// isShortcutMatchingDeleted reports whether the shortcut matches the trash filter of the find.
+func isShortcutMatchingDeleted(shortcut *storepb.Shortcut, find *FindShortcut) bool {
+	if find.OnlyDeleted {
+		return shortcut.DeletedTs > 0
+	}
+	return find.IncludeDeleted || shortcut.DeletedTs == 0
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// ShortcutRevision is an edit of a shortcut, which is stored as a shortcut update activity.
+type ShortcutRevision struct {
+	// ID is the id of the shortcut update activity.
+	ID         int32
+	ShortcutID int32
+	EditorID   int32
+	CreatedTs  int64
+	// Previous and Current are the shortcut before and after the edit.
+	Previous *storepb.Shortcut
+	Current  *storepb.Shortcut
+}
+
+type FindShortcutRevision struct {
+	ShortcutID int32
+}
+
+// UpdateShortcutRevision is the revision recorded by an update of a shortcut.
+type UpdateShortcutRevision struct {
+	EditorID int32
+	// Previous is the shortcut before the update.
+	Previous *storepb.Shortcut
+}
+
+// ConvertShortcutRevisionToActivity returns the shortcut update activity of the revision, with the shortcut
+// after the update. It returns nil if the update did not change the shortcut, which is not recorded.
+func ConvertShortcutRevisionToActivity(revision *UpdateShortcutRevision, current *storepb.Shortcut) (*Activity, error) {
+	if isSameShortcutContent(revision.Previous, current) {
+		return nil, nil
+	}
+	payload, err := protojson.Marshal(&storepb.ActivityShortcutUpdatePayload{
+		ShortcutId: current.Id,
+		Previous:   revision.Previous,
+		Current:    current,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal activity payload")
+	}
+	return &Activity{
+		CreatorID: revision.EditorID,
+		Type:      ActivityShortcutUpdate,
+		Level:     ActivityInfo,
+		Payload:   string(payload),
+	}, nil
+}
+
+// ListShortcutRevisions returns the revisions of a shortcut, the most recent first.
+func (s *Store) ListShortcutRevisions(ctx context.Context, find *FindShortcutRevision) ([]*ShortcutRevision, error) {
+	activities, err := s.driver.ListActivities(ctx, &FindActivity{
+		Type:              ActivityShortcutUpdate,
+		PayloadShortcutID: &find.ShortcutID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := []*ShortcutRevision{}
+	for _, activity := range activities {
+		revision, err := convertActivityToShortcutRevision(activity)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, revision)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID > list[j].ID
+	})
+	return list, nil
+}
+
+func convertActivityToShortcutRevision(activity *Activity) (*ShortcutRevision, error) {
+	payload := &storepb.ActivityShortcutUpdatePayload{}
+	if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal payload")
+	}
+	return &ShortcutRevision{
+		ID:         activity.ID,
+		ShortcutID: payload.ShortcutId,
+		EditorID:   activity.CreatorID,
+		CreatedTs:  activity.CreatedTs,
+		Previous:   payload.Previous,
+		Current:    payload.Current,
+	}, nil
+}
+
+// isSameShortcutContent reports whether the shortcuts are the same apart from the update time.
+func isSameShortcutContent(a, b *storepb.Shortcut) bool {
+	a, b = proto.Clone(a).(*storepb.Shortcut), proto.Clone(b).(*storepb.Shortcut)
+	a.UpdatedTs, b.UpdatedTs = 0, 0
+	return proto.Equal(a, b)
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	// SearchHighlightStart and SearchHighlightEnd wrap the matched terms in search snippets.
+	SearchHighlightStart = "<mark>"
+	SearchHighlightEnd   = "</mark>"
+	// SearchMatchStart and SearchMatchEnd wrap the matched terms in the raw snippets of the drivers.
+	// They are private use characters, which are replaced with the highlight tags once the snippets are escaped.
+	SearchMatchStart = "\uE000"
+	SearchMatchEnd   = "\uE001"
+)
+
+// SearchShortcut is the options of the full-text search of shortcuts.
+// Each term is matched as a prefix against the name, aliases, title, description, tags and link of shortcuts,
+// and a shortcut has to match all terms.
+type SearchShortcut struct {
+	Terms []string
+	// ViewerID limits the results to the shortcuts visible to the user, the same as FindShortcut.ViewerID.
+	ViewerID *int32
+	Limit    int
+}
+
+// ShortcutSearchResult is a matched shortcut with its rank and highlighted snippet.
+// Drivers set the ShortcutID and the raw snippet, and the store fills in the Shortcut and escapes the snippet.
+type ShortcutSearchResult struct {
+	ShortcutID int32
+	Shortcut   *storepb.Shortcut
+	// Rank is the relevance of the shortcut, where higher is more relevant.
+	Rank float64
+	// Snippet is the HTML of the matched text, where the matched terms are highlighted.
+	Snippet string
+}
+
+// GetSearchTerms splits the search query into lowercase terms of letters and digits.
+func GetSearchTerms(query string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return terms
+}
+
+// SearchShortcuts returns the matched shortcuts sorted by relevance.
+func (s *Store) SearchShortcuts(ctx context.Context, search *SearchShortcut) ([]*ShortcutSearchResult, error) {
+	if len(search.Terms) == 0 {
+		return []*ShortcutSearchResult{}, nil
+	}
+	results, err := s.driver.SearchShortcuts(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcutIDs := []int32{}
+	for _, result := range results {
+		shortcutIDs = append(shortcutIDs, result.ShortcutID)
+	}
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+		IDList: shortcutIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutMap := map[int32]*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		shortcutMap[shortcut.Id] = shortcut
+	}
+
+	list := []*ShortcutSearchResult{}
+	for _, result := range results {
+		// The shortcut may have been deleted in between.
+		if shortcut, ok := shortcutMap[result.ShortcutID]; ok {
+			result.Shortcut = shortcut
+			result.Snippet = escapeSnippet(result.Snippet)
+			list = append(list, result)
+		}
+	}
+	return list, nil
+}
+
+// escapeSnippet escapes the raw snippet as HTML, and highlights its matched terms.
+func escapeSnippet(snippet string) string {
+	return strings.NewReplacer(SearchMatchStart, SearchHighlightStart, SearchMatchEnd, SearchHighlightEnd).Replace(html.EscapeString(snippet))
+}
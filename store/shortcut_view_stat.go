@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+
+	"github.com/mssola/useragent"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// ShortcutViewStat is the daily view count of a shortcut for a combination of referer, device and browser.
+type ShortcutViewStat struct {
+	ShortcutID int32
+	// DayTs is the timestamp of the start of the day in UTC.
+	DayTs   int64
+	Referer string
+	Device  string
+	Browser string
+	Count   int32
+}
+
+type FindShortcutViewStat struct {
+	ShortcutID *int32
+	// DayTsAfter matches the stats of the days starting at or after the timestamp.
+	DayTsAfter *int64
+	// DayTsBefore matches the stats of the days starting before the timestamp.
+	DayTsBefore *int64
+}
+
+func (s *Store) ListShortcutViewStats(ctx context.Context, find *FindShortcutViewStat) ([]*ShortcutViewStat, error) {
+	return s.driver.ListShortcutViewStats(ctx, find)
+}
+
+// CountShortcutViews returns the total view count of the matched shortcut view stats.
+func (s *Store) CountShortcutViews(ctx context.Context, find *FindShortcutViewStat) (int32, error) {
+	return s.driver.CountShortcutViews(ctx, find)
+}
+
+// GetDayTs returns the timestamp of the start of the day in UTC that contains the given timestamp.
+func GetDayTs(ts int64) int64 {
+	return ts - (ts%secondsPerDay+secondsPerDay)%secondsPerDay
+}
+
+// ConvertActivityToShortcutViewStat returns the view stat with a count of 1 for the shortcut view activity.
+func ConvertActivityToShortcutViewStat(activity *Activity) (*ShortcutViewStat, error) {
+	payload := &storepb.ActivityShorcutViewPayload{}
+	if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal payload")
+	}
+	ua := useragent.New(payload.UserAgent)
+	browserName, _ := ua.Browser()
+	return &ShortcutViewStat{
+		ShortcutID: payload.ShortcutId,
+		DayTs:      GetDayTs(activity.CreatedTs),
+		Referer:    payload.Referer,
+		Device:     ua.OSInfo().Name,
+		Browser:    browserName,
+		Count:      1,
+	}, nil
+}
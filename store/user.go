@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
 // Role is the type of a role.
@@ -37,6 +39,9 @@ type UpdateUser struct {
 	Nickname     *string
 	PasswordHash *string
 	Role         *Role
+	// TransferTo is the id of the user who takes over the shortcuts and collections
+	// of the updated user in the same transaction when not nil, e.g. when archiving the user.
+	TransferTo *int32
 }
 
 type FindUser struct {
@@ -49,6 +54,15 @@ type FindUser struct {
 
 type DeleteUser struct {
 	ID int32
+	// TransferTo is the id of the user who takes over the shortcuts and collections
+	// of the deleted user in the same transaction when not nil.
+	TransferTo *int32
+}
+
+// TransferUserResources reassigns the shortcuts and collections created by a user to another user.
+type TransferUserResources struct {
+	FromUserID int32
+	ToUserID   int32
 }
 
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
@@ -66,6 +80,9 @@ func (s *Store) UpdateUser(ctx context.Context, update *UpdateUser) (*User, erro
 		return nil, err
 	}
 	s.userCache.Store(user.ID, user)
+	if update.TransferTo != nil {
+		s.evictShortcutsCreatedBy(update.ID)
+	}
 	return user, nil
 }
 
@@ -105,5 +122,25 @@ func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 	}
 
 	s.userCache.Delete(delete.ID)
+	s.evictShortcutsCreatedBy(delete.ID)
 	return nil
 }
+
+func (s *Store) TransferUserResources(ctx context.Context, transfer *TransferUserResources) error {
+	if err := s.driver.TransferUserResources(ctx, transfer); err != nil {
+		return err
+	}
+
+	s.evictShortcutsCreatedBy(transfer.FromUserID)
+	return nil
+}
+
+// evictShortcutsCreatedBy removes the cached shortcuts of the creator, whose rows were deleted or transferred.
+func (s *Store) evictShortcutsCreatedBy(creatorID int32) {
+	s.shortcutCache.Range(func(key, value any) bool {
+		if shortcut, ok := value.(*storepb.Shortcut); ok && shortcut.CreatorId == creatorID {
+			s.shortcutCache.Delete(key)
+		}
+		return true
+	})
+}
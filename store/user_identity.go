@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+)
+
+// UserIdentity links a user to their account in an identity provider.
+type UserIdentity struct {
+	UserID int32
+	// ProviderID is the id of the identity provider in the workspace setting.
+	ProviderID string
+	// Subject is the identifier of the account in the identity provider.
+	Subject   string
+	CreatedTs int64
+}
+
+type FindUserIdentity struct {
+	UserID     *int32
+	ProviderID *string
+	Subject    *string
+}
+
+func (s *Store) CreateUserIdentity(ctx context.Context, create *UserIdentity) (*UserIdentity, error) {
+	return s.driver.CreateUserIdentity(ctx, create)
+}
+
+func (s *Store) ListUserIdentities(ctx context.Context, find *FindUserIdentity) ([]*UserIdentity, error) {
+	return s.driver.ListUserIdentities(ctx, find)
+}
+
+func (s *Store) GetUserIdentity(ctx context.Context, find *FindUserIdentity) (*UserIdentity, error) {
+	identities, err := s.ListUserIdentities(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(identities) == 0 {
+		return nil, nil
+	}
+
+	identity := identities[0]
+	return identity, nil
+}
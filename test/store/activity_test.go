@@ -29,3 +29,29 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
 }
+
+func TestActivityStoreCreatedTsRange(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	activity, err := ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   "",
+	})
+	require.NoError(t, err)
+	createdTsBefore := activity.CreatedTs + 1
+	list, err := ts.ListActivities(ctx, &store.FindActivity{
+		CreatedTsAfter:  &activity.CreatedTs,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	list, err = ts.ListActivities(ctx, &store.FindActivity{
+		CreatedTsBefore: &activity.CreatedTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+}
@@ -0,0 +1,116 @@
+package teststore
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestBackupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "it's",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	backupPath := filepath.Join(t.TempDir(), "backup")
+	err = ts.Backup(ctx, backupPath)
+	require.NoError(t, err)
+
+	if os.Getenv("DRIVER") == "postgres" {
+		dump, err := os.ReadFile(backupPath)
+		require.NoError(t, err)
+		require.Contains(t, string(dump), `INSERT INTO "shortcut"`)
+		require.Contains(t, string(dump), `it''s`)
+		return
+	}
+	if os.Getenv("DRIVER") == "mysql" {
+		dump, err := os.ReadFile(backupPath)
+		require.NoError(t, err)
+		require.Contains(t, string(dump), "INSERT INTO `shortcut`")
+		require.Contains(t, string(dump), `it''s`)
+		return
+	}
+	db, err := sql.Open("sqlite", backupPath)
+	require.NoError(t, err)
+	defer db.Close()
+	var name string
+	err = db.QueryRowContext(ctx, `SELECT name FROM shortcut`).Scan(&name)
+	require.NoError(t, err)
+	require.Equal(t, "it's", name)
+}
+
+func TestRestoreStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "before",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	backupPath := filepath.Join(t.TempDir(), "backup")
+	err = ts.Backup(ctx, backupPath)
+	require.NoError(t, err)
+
+	// Changes after the backup are undone by restoring it, including the cached ones.
+	name := "after"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "new",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	err = ts.Restore(ctx, backupPath)
+	require.NoError(t, err)
+	restored, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "before", restored.Name)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	restoredUser, err := ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, restoredUser.Email)
+
+	// New rows do not collide with the restored ones.
+	created, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "new",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, shortcut.Id, created.Id)
+
+	err = ts.Restore(ctx, filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestCollaboratorStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collaborator, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "collaborator@example.com",
+		Nickname: "collaborator",
+	})
+	require.NoError(t, err)
+
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "payroll",
+		Link:       "https://payroll.example.com",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &collaborator.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	_, err = ts.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		UserID:       collaborator.ID,
+		Role:         store.CollaboratorRoleViewer,
+	})
+	require.NoError(t, err)
+	// The private shortcut is visible to its collaborators.
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &collaborator.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+
+	// Upserting again changes the role of the collaborator.
+	_, err = ts.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		UserID:       collaborator.ID,
+		Role:         store.CollaboratorRoleEditor,
+	})
+	require.NoError(t, err)
+	collaborators, err := ts.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collaborators))
+	require.Equal(t, store.CollaboratorRoleEditor, collaborators[0].Role)
+
+	// Transferring the ownership changes the creator, who is not a collaborator anymore.
+	err = ts.TransferResourceOwnership(ctx, &store.TransferResourceOwnership{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		ToUserID:     collaborator.ID,
+	})
+	require.NoError(t, err)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, collaborator.ID, shortcut.CreatorId)
+	collaborators, err = ts.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collaborators))
+
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   admin.ID,
+		Name:        "finance",
+		Title:       "Finance",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		ResourceID:   collection.Id,
+		UserID:       collaborator.ID,
+		Role:         store.CollaboratorRoleViewer,
+	})
+	require.NoError(t, err)
+	found, err := ts.GetCollaborator(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		ResourceID:   &collection.Id,
+		UserID:       &collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.CollaboratorRoleViewer, found.Role)
+
+	// Deleting the resources deletes their collaborators.
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id})
+	require.NoError(t, err)
+	err = ts.DeleteCollection(ctx, &store.DeleteCollection{ID: collection.Id})
+	require.NoError(t, err)
+	collaborators, err = ts.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		UserID:       &collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collaborators))
+	collaborators, err = ts.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeCollection,
+		UserID:       &collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collaborators))
+}
@@ -0,0 +1,97 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestCopyData(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	source, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, source)
+	require.NoError(t, source.Migrate(ctx))
+	ts := store.New(source, profile)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	// Deleted rows leave gaps in the ids, which are kept by the copy.
+	for i := 0; i < 3; i++ {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       fmt.Sprintf("shortcut-%d", i),
+			Link:       "https://example.com",
+			Visibility: storepb.Visibility_PUBLIC,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+			Aliases:    []string{fmt.Sprintf("alias-%d", i)},
+		})
+		require.NoError(t, err)
+		if i == 0 {
+			require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id}))
+		}
+	}
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "dev",
+		Title:       "Dev",
+		ShortcutIds: []int32{shortcuts[0].Id, shortcuts[1].Id},
+		Visibility:  storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+
+	targetProfile := test.GetTestingProfile(t)
+	targetProfile.Driver = "sqlite"
+	targetProfile.DSN = filepath.Join(t.TempDir(), "target.db")
+	target, err := db.NewDBDriver(targetProfile)
+	require.NoError(t, err)
+	require.NoError(t, target.Migrate(ctx))
+	targetStore := store.New(target, targetProfile)
+	defer targetStore.Close()
+
+	results, err := db.CopyData(ctx, profile.Driver, source, targetProfile.Driver, target)
+	require.NoError(t, err)
+	for _, result := range results {
+		require.Equal(t, result.SourceRows, result.TargetRows, result.Table)
+	}
+
+	copiedUser, err := targetStore.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, copiedUser.Email)
+	require.Equal(t, user.CreatedTs, copiedUser.CreatedTs)
+	for _, shortcut := range shortcuts {
+		copiedShortcut, err := targetStore.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+		require.NoError(t, err)
+		require.Equal(t, shortcut.Name, copiedShortcut.Name)
+		require.Equal(t, shortcut.Aliases, copiedShortcut.Aliases)
+		require.Equal(t, shortcut.CreatedTs, copiedShortcut.CreatedTs)
+	}
+	copiedCollection, err := targetStore.GetCollection(ctx, &store.FindCollection{ID: &collection.Id})
+	require.NoError(t, err)
+	require.Equal(t, collection.ShortcutIds, copiedCollection.ShortcutIds)
+
+	// New rows get new ids.
+	created, err := targetStore.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "new",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Greater(t, created.Id, shortcuts[len(shortcuts)-1].Id)
+
+	// The tables of the target database must be empty.
+	_, err = db.CopyData(ctx, profile.Driver, source, targetProfile.Driver, target)
+	require.Error(t, err)
+}
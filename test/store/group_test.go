@@ -0,0 +1,120 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@example.com",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	outsider, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "outsider@example.com",
+		Nickname: "outsider",
+	})
+	require.NoError(t, err)
+
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name:        "finance",
+		Description: "Finance team",
+	})
+	require.NoError(t, err)
+	name := "finance-team"
+	group, err = ts.UpdateGroup(ctx, &store.UpdateGroup{
+		ID:   group.ID,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, group.Name)
+	require.Equal(t, "Finance team", group.Description)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	// Adding a member twice keeps a single membership.
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	members, err := ts.ListGroupMembers(ctx, &store.FindGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members))
+	groups, err := ts.ListGroups(ctx, &store.FindGroup{MemberID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groups))
+	isMember, err := ts.IsGroupMember(ctx, outsider.ID, []int32{group.ID})
+	require.NoError(t, err)
+	require.False(t, isMember)
+
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "payroll",
+		Link:       "https://payroll.example.com",
+		Title:      "Payroll",
+		Visibility: storepb.Visibility_GROUP,
+		GroupIds:   []int32{group.ID},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{group.ID}, shortcut.GroupIds)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   admin.ID,
+		Name:        "finance",
+		Title:       "Finance",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_GROUP,
+		GroupIds:    []int32{group.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{group.ID}, collection.GroupIds)
+
+	// Group shortcuts are only visible to their creator and the members of the groups.
+	for _, item := range []struct {
+		viewerID int32
+		visible  bool
+	}{
+		{admin.ID, true},
+		{member.ID, true},
+		{outsider.ID, false},
+	} {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &item.viewerID})
+		require.NoError(t, err)
+		require.Equal(t, item.visible, len(shortcuts) == 1)
+		results, err := ts.SearchShortcuts(ctx, &store.SearchShortcut{
+			Terms:    store.GetSearchTerms("payroll"),
+			ViewerID: &item.viewerID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, item.visible, len(results) == 1)
+	}
+
+	// Removing the member hides the shortcut from them.
+	err = ts.DeleteGroupMember(ctx, &store.DeleteGroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	// Deleting the group stops sharing the shortcut and the collection with it.
+	err = ts.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcut.GroupIds))
+	collection, err = ts.GetCollection(ctx, &store.FindCollection{ID: &collection.Id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collection.GroupIds))
+	groups, err = ts.ListGroups(ctx, &store.FindGroup{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groups))
+}
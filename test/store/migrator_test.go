@@ -0,0 +1,125 @@
+package teststore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/server/version"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+// setNextVersion sets the current version to the one of the pending migrations until the end of the test.
+func setNextVersion(t *testing.T) {
+	currentVersion := version.Version
+	version.Version = "1.1.0"
+	t.Cleanup(func() {
+		version.Version = currentVersion
+	})
+}
+
+func TestListPendingMigrations(t *testing.T) {
+	ctx := context.Background()
+	setNextVersion(t)
+	profile := test.GetTestingProfile(t)
+	profile.Mode = "prod"
+	profile.Version = version.GetCurrentVersion(profile.Mode)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	defer dbDriver.Close()
+	resetTestingDB(ctx, profile, dbDriver)
+
+	// New databases get the latest schema.
+	migrations, err := dbDriver.ListPendingMigrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(migrations))
+	require.Equal(t, profile.Version, migrations[0].Version)
+	require.Equal(t, []string{"migration/prod/LATEST.sql"}, migrations[0].Files)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	migrations, err = dbDriver.ListPendingMigrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(migrations))
+
+	// Databases of older versions get the migrations of the newer minor versions.
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DELETE FROM migration_history")
+	require.NoError(t, err)
+	_, err = dbDriver.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{Version: "1.0.0"})
+	require.NoError(t, err)
+	migrations, err = dbDriver.ListPendingMigrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(migrations))
+	require.Equal(t, "1.1.0", migrations[0].Version)
+	require.NotEmpty(t, migrations[0].Files)
+	for _, file := range migrations[0].Files {
+		require.True(t, strings.HasPrefix(file, "migration/prod/1.1/"), file)
+	}
+}
+
+func TestMigrateSQLiteBackup(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("backups before migration are only made for sqlite")
+	}
+	setNextVersion(t)
+	profile.Mode = "prod"
+	profile.Version = version.GetCurrentVersion(profile.Mode)
+	profile.MigrationBackupRetention = 2
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	defer dbDriver.Close()
+	require.NoError(t, dbDriver.Migrate(ctx))
+	backupFilePaths, err := filepath.Glob(filepath.Join(profile.Data, "slash_*_backup.db"))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(backupFilePaths))
+
+	// Older backups are removed except the most recent ones.
+	for i := 0; i < 4; i++ {
+		oldBackupFilePath := filepath.Join(profile.Data, fmt.Sprintf("slash_old_%d_backup.db", i))
+		require.NoError(t, os.WriteFile(oldBackupFilePath, []byte{}, 0644))
+		modTime := time.Now().Add(-time.Duration(10-i) * time.Hour)
+		require.NoError(t, os.Chtimes(oldBackupFilePath, modTime, modTime))
+	}
+
+	// The second migration file fails on the latest schema, which rolls back the first one too.
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DROP TABLE shortcut_alias")
+	require.NoError(t, err)
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DELETE FROM migration_history")
+	require.NoError(t, err)
+	_, err = dbDriver.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{Version: "1.0.0"})
+	require.NoError(t, err)
+	require.Error(t, dbDriver.Migrate(ctx))
+	var count int
+	require.NoError(t, dbDriver.GetDB().QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE name = 'shortcut_alias'").Scan(&count))
+	require.Equal(t, 0, count)
+	migrationHistories, err := dbDriver.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(migrationHistories))
+	require.Equal(t, "1.0.0", migrationHistories[0].Version)
+
+	backupFilePaths, err = filepath.Glob(filepath.Join(profile.Data, "slash_*_backup.db"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(backupFilePaths))
+	require.Contains(t, backupFilePaths, filepath.Join(profile.Data, "slash_old_3_backup.db"))
+	backupFilePath := filepath.Join(profile.Data, fmt.Sprintf("slash_%s_", profile.Version))
+	var backupDB *sql.DB
+	for _, path := range backupFilePaths {
+		if strings.HasPrefix(path, backupFilePath) {
+			backupDB, err = sql.Open("sqlite", path)
+			require.NoError(t, err)
+		}
+	}
+	require.NotNil(t, backupDB)
+	defer backupDB.Close()
+	require.NoError(t, backupDB.QueryRowContext(ctx, "SELECT COUNT(*) FROM migration_history").Scan(&count))
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,96 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Aliases:    []string{"t"},
+	})
+	require.NoError(t, err)
+
+	// Edits which change nothing are not recorded.
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &shortcut.Link,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: user.ID,
+			Previous: shortcut,
+		},
+	})
+	require.NoError(t, err)
+	revisions, err := ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+
+	// The revisions are recorded along with the updates.
+	newLink := "https://new.link"
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &newLink,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: user.ID,
+			Previous: shortcut,
+		},
+	})
+	require.NoError(t, err)
+	newTitle := "New title"
+	retitledShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:    shortcut.Id,
+		Title: &newTitle,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: user.ID,
+			Previous: updatedShortcut,
+		},
+	})
+	require.NoError(t, err)
+
+	// Revisions of other shortcuts and other activities are not listed.
+	otherShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "other",
+		Link:       "https://other.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   otherShortcut.Id,
+		Link: &newLink,
+		Revision: &store.UpdateShortcutRevision{
+			EditorID: user.ID,
+			Previous: otherShortcut,
+		},
+	})
+	require.NoError(t, err)
+
+	revisions, err = ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(revisions))
+	require.True(t, proto.Equal(retitledShortcut, revisions[0].Current))
+	require.Equal(t, user.ID, revisions[1].EditorID)
+	require.Equal(t, shortcut.Id, revisions[1].ShortcutID)
+	require.True(t, proto.Equal(shortcut, revisions[1].Previous))
+	require.True(t, proto.Equal(updatedShortcut, revisions[1].Current))
+}
@@ -3,6 +3,7 @@ package teststore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -53,3 +54,313 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestShortcutAliasStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "github",
+		Link:       "https://github.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Aliases:    []string{"gh"},
+	})
+	require.NoError(t, err)
+	alias := "gh"
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &alias,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, shortcut.Id, found.Id)
+	require.Equal(t, []string{"gh"}, found.Aliases)
+
+	aliases := []string{"git", "hub"}
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      shortcut.Id,
+		Aliases: &aliases,
+	})
+	require.NoError(t, err)
+	require.Equal(t, aliases, updatedShortcut.Aliases)
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &alias,
+	})
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "gitlab",
+		Link:       "https://gitlab.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Aliases:    []string{"git"},
+	})
+	require.Error(t, err)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	aliasName := "hub"
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &aliasName,
+	})
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
+
+func TestShortcutExpirationStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	now := time.Now().Unix()
+	expiredShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:    user.ID,
+		Name:         "expired",
+		Link:         "https://expired.link",
+		Visibility:   storepb.Visibility_PUBLIC,
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		StartTs:      now - 7200,
+		ExpireTs:     now - 3600,
+		FallbackLink: "https://fallback.link",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "active",
+		Link:       "https://active.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ExpireTs:   now + 3600,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "forever",
+		Link:       "https://forever.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ExpiredBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, expiredShortcut.Id, shortcuts[0].Id)
+
+	expireTs := int64(0)
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:       expiredShortcut.Id,
+		ExpireTs: &expireTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), updatedShortcut.ExpireTs)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ExpiredBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestShortcutListOptionsStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"go", "gh", "docs", "gh_private"} {
+		visibility := storepb.Visibility_PUBLIC
+		if name == "gh_private" {
+			visibility = storepb.Visibility_PRIVATE
+		}
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	otherUserID := user.ID + 1
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &otherUserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(shortcuts))
+
+	namePrefix := "gh"
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		NamePrefix: &namePrefix,
+		OrderBy:    store.ShortcutOrderByName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	require.Equal(t, "gh", shortcuts[0].Name)
+	require.Equal(t, "gh_private", shortcuts[1].Name)
+	// The name prefix ignores the case.
+	namePrefix = "GH"
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		NamePrefix: &namePrefix,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// The similar name candidates start with the name or have a length within the distance.
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		SimilarName: &store.SimilarName{Name: "G", MaxDistance: 1},
+		OrderBy:     store.ShortcutOrderByName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shortcuts))
+	require.Equal(t, "gh", shortcuts[0].Name)
+	require.Equal(t, "gh_private", shortcuts[1].Name)
+	require.Equal(t, "go", shortcuts[2].Name)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		SimilarName: &store.SimilarName{Name: "weekly-reports", MaxDistance: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	limit, offset := 2, 1
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy:   store.ShortcutOrderByName,
+		OrderDesc: true,
+		Limit:     &limit,
+		Offset:    &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	require.Equal(t, "gh_private", shortcuts[0].Name)
+	require.Equal(t, "gh", shortcuts[1].Name)
+}
+
+func TestShortcutSearchStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	githubShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "gh",
+		Link:        "https://github.com",
+		Title:       "GitHub",
+		Description: "Where the code of the team lives",
+		Tags:        []string{"code"},
+		Visibility:  storepb.Visibility_PUBLIC,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "codesearch",
+		Link:       "https://cs.example.com",
+		Title:      "Private code search",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	results, err := ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("Code"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(results))
+	// The name match ranks higher than the tag match.
+	require.Equal(t, "codesearch", results[0].Shortcut.Name)
+	require.Equal(t, githubShortcut.Id, results[1].ShortcutID)
+	require.Contains(t, results[1].Snippet, store.SearchHighlightStart+"code"+store.SearchHighlightEnd)
+
+	otherUserID := user.ID + 1
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms:    store.GetSearchTerms("code"),
+		ViewerID: &otherUserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, githubShortcut.Id, results[0].ShortcutID)
+
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("git team"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+
+	description := "Where the repositories live"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:          githubShortcut.Id,
+		Description: &description,
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("git team"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+
+	// The aliases are searched like the name.
+	aliases := []string{"octocat"}
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      githubShortcut.Id,
+		Aliases: &aliases,
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("octo"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, githubShortcut.Id, results[0].ShortcutID)
+	aliases = []string{}
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      githubShortcut.Id,
+		Aliases: &aliases,
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("octo"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+
+	// The snippets are escaped HTML, besides the highlights.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "xss",
+		Link:        "https://xss.example.com",
+		Description: `<img src=x onerror=alert(1)> payload`,
+		Visibility:  storepb.Visibility_PUBLIC,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("payload"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.NotContains(t, results[0].Snippet, "<img")
+	require.Contains(t, results[0].Snippet, "&lt;img")
+	require.Contains(t, results[0].Snippet, store.SearchHighlightStart+"payload"+store.SearchHighlightEnd)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: githubShortcut.Id,
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("repositories"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+}
@@ -0,0 +1,66 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutViewStatStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	count, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), count)
+
+	for _, referer := range []string{"https://a.com", "https://a.com", "https://b.com"} {
+		payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{
+			ShortcutId: shortcut.Id,
+			Referer:    referer,
+			UserAgent:  "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		})
+		require.NoError(t, err)
+		_, err = ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: 0,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		})
+		require.NoError(t, err)
+	}
+
+	count, err = ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), count)
+	stats, err := ts.ListShortcutViewStats(ctx, &store.FindShortcutViewStat{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats))
+	refererCountMap := map[string]int32{}
+	for _, stat := range stats {
+		require.Equal(t, "Chrome", stat.Browser)
+		require.Equal(t, int64(0), stat.DayTs%(24*60*60))
+		refererCountMap[stat.Referer] += stat.Count
+	}
+	require.Equal(t, map[string]int32{"https://a.com": 2, "https://b.com": 1}, refererCountMap)
+}
@@ -31,14 +31,31 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		_, err := dbDriver.GetDB().ExecContext(ctx, `
 		DROP TABLE IF EXISTS migration_history CASCADE;
 		DROP TABLE IF EXISTS workspace_setting CASCADE;
-		DROP TABLE IF EXISTS "user" CASCADE;
 		DROP TABLE IF EXISTS user_setting CASCADE;
+		DROP TABLE IF EXISTS user_identity CASCADE;
+		DROP TABLE IF EXISTS shortcut_collaborator CASCADE;
+		DROP TABLE IF EXISTS collection_collaborator CASCADE;
+		DROP TABLE IF EXISTS shortcut_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;
+		DROP TABLE IF EXISTS shortcut_alias CASCADE;
+		DROP TABLE IF EXISTS shortcut_view_stat CASCADE;
 		DROP TABLE IF EXISTS shortcut CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
-		DROP TABLE IF EXISTS collection CASCADE;`)
+		DROP TABLE IF EXISTS collection CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS "user" CASCADE;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
 		}
 	}
+	if profile.Driver == "mysql" {
+		// The MySQL driver runs a single statement at a time, and the tables referencing others are dropped first.
+		for _, table := range []string{"migration_history", "workspace_setting", "user_setting", "user_identity", "shortcut_collaborator", "collection_collaborator", "shortcut_group", "user_group_member", "shortcut_alias", "shortcut_view_stat", "shortcut", "activity", "collection", "user_group", "user"} {
+			if _, err := dbDriver.GetDB().ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS `%s`", table)); err != nil {
+				fmt.Printf("failed to reset testing db, error: %+v\n", err)
+				panic(err)
+			}
+		}
+	}
 }
@@ -0,0 +1,177 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://docs.example.com",
+		Title:      "Documentation",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "onboarding",
+		Title:       "Onboarding",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+
+	// Moving the shortcut to the trash hides it from the lists, lookups and search.
+	deletedTs := time.Now().Unix()
+	deletedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, deletedTs, deletedShortcut.DeletedTs)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	results, err := ts.SearchShortcuts(ctx, &store.SearchShortcut{
+		Terms: store.GetSearchTerms("documentation"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{Name: &shortcut.Name, IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, found.Id)
+
+	// Restoring the shortcut brings it back.
+	restoredTs := int64(0)
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &restoredTs,
+	})
+	require.NoError(t, err)
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	// The collection in the trash still loses the purged shortcut.
+	_, err = ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
+	payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{
+		ShortcutId: shortcut.Id,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		Type:    store.ActivityShortcutView,
+		Level:   store.ActivityInfo,
+		Payload: string(payload),
+	})
+	require.NoError(t, err)
+	stats, err := ts.ListShortcutViewStats(ctx, &store.FindShortcutViewStat{ShortcutID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stats))
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, 0, len(collections[0].ShortcutIds))
+	// The purged shortcut leaves no view stats behind.
+	stats, err = ts.ListShortcutViewStats(ctx, &store.FindShortcutViewStat{ShortcutID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(stats))
+}
+
+func TestTrashArchivedShortcutStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	now := time.Now().Unix()
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:    user.ID,
+		Name:         "launch",
+		Link:         "https://launch.example.com",
+		Visibility:   storepb.Visibility_PUBLIC,
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		ExpireTs:     now - 3600,
+		FallbackLink: "https://example.com",
+	})
+	require.NoError(t, err)
+
+	// Archiving an expired shortcut keeps it out of the trash, so that it still resolves to its fallback link.
+	archived := store.Archived
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &shortcut.Name})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, found.RowStatus)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	// Deleting the archived shortcut moves it to the trash with its row status, where it can be purged.
+	deletedTs := now
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{OnlyDeleted: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, storepb.RowStatus_ARCHIVED, shortcuts[0].RowStatus)
+
+	// The shortcuts in the trash are not matched by the expiration job, which only archives the normal ones.
+	normal := store.Normal
+	restoredTs := int64(0)
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &normal,
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{RowStatus: &normal, ExpiredBefore: &now})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &restoredTs,
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{RowStatus: &normal, ExpiredBefore: &now})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, shortcut.Id, shortcuts[0].Id)
+}
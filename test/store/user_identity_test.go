@@ -0,0 +1,46 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestUserIdentityStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	identity, err := ts.CreateUserIdentity(ctx, &store.UserIdentity{
+		UserID:     user.ID,
+		ProviderID: "corp",
+		Subject:    "subject",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, identity.CreatedTs)
+	// A subject of a provider is linked to a single user.
+	_, err = ts.CreateUserIdentity(ctx, &store.UserIdentity{
+		UserID:     user.ID,
+		ProviderID: "corp",
+		Subject:    "subject",
+	})
+	require.Error(t, err)
+
+	providerID, subject := "corp", "subject"
+	found, err := ts.GetUserIdentity(ctx, &store.FindUserIdentity{
+		ProviderID: &providerID,
+		Subject:    &subject,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.UserID)
+
+	// Deleting the user deletes their identities.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{ID: user.ID})
+	require.NoError(t, err)
+	identities, err := ts.ListUserIdentities(ctx, &store.FindUserIdentity{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(identities))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -36,6 +37,106 @@ func TestUserStore(t *testing.T) {
 	require.Equal(t, 0, len(users))
 }
 
+func TestTransferUserResources(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	leaver, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "leaver@example.com",
+		Nickname: "leaver",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  leaver.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   leaver.ID,
+		Name:        "onboarding",
+		Title:       "Onboarding",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertCollaborator(ctx, &store.Collaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   shortcut.Id,
+		UserID:       admin.ID,
+		Role:         store.CollaboratorRoleEditor,
+	})
+	require.NoError(t, err)
+
+	err = ts.TransferUserResources(ctx, &store.TransferUserResources{
+		FromUserID: leaver.ID,
+		ToUserID:   admin.ID,
+	})
+	require.NoError(t, err)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, shortcut.CreatorId)
+	collection, err = ts.GetCollection(ctx, &store.FindCollection{ID: &collection.Id})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, collection.CreatorId)
+	// The new owner is not a collaborator of their own shortcut anymore.
+	collaborators, err := ts.ListCollaborators(ctx, &store.FindCollaborator{
+		ResourceType: store.CollaboratorResourceTypeShortcut,
+		ResourceID:   &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collaborators))
+
+	// Deleting a user with a transfer keeps their shortcuts.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  leaver.ID,
+		Name:       "handbook",
+		Link:       "https://handbook.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:         leaver.ID,
+		TransferTo: &admin.ID,
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{CreatorID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// Archiving a user with a transfer moves their shortcuts in the same update.
+	archived, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "archived@example.com",
+		Nickname: "archived",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  archived.ID,
+		Name:       "roadmap",
+		Link:       "https://roadmap.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	rowStatus := store.Archived
+	archived, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:         archived.ID,
+		RowStatus:  &rowStatus,
+		TransferTo: &admin.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, archived.RowStatus)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{CreatorID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shortcuts))
+}
+
 // createTestingAdminUser creates a testing admin user.
 func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{